@@ -0,0 +1,209 @@
+package commonregex
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Address is a postal address found by FindAddresses. Its fields are written
+// as found, except Country.
+type Address struct {
+	// Street is the street line, like "123 Main Street", or the post office
+	// box, like "PO Box 42"
+	Street string
+	// Unit is the apartment, suite, floor or room, like "Suite 400", or
+	// empty if there is none
+	Unit string
+	City string
+	// State is the US state, the Canadian province or, if the address gives
+	// one, the UK county
+	State      string
+	PostalCode string
+	// Country is the ISO 3166-1 alpha-2 code of the country whose format the
+	// address follows: "US", "CA" or "GB"
+	Country string
+	// Start and End are the offsets of the address in the text searched,
+	// from the street line to the postal code or country
+	Start, End int
+}
+
+// addressCityPattern matches a city or county name on a single line: words
+// starting with a capital, joined by the small words of names like "Newcastle
+// upon Tyne" or "Coeur d'Alene", so that it does not take in the sentence
+// before an address
+const addressCityPattern = `[A-Z][A-Za-z.'\-]*(?: (?:(?:upon|on|in|the|and|of|de|du|la|le|sur|au) )*(?:d')?[A-Z][A-Za-z.'\-]*)*?`
+
+// addressSeparatorPattern matches what separates the parts of an address
+const addressSeparatorPattern = `(?:,\s*|\s+)`
+
+var (
+	// addressDirectionalRegex matches a directional after the street
+	// suffix, as in "1600 Pennsylvania Avenue NW"
+	addressDirectionalRegex = regexp.MustCompile(`^[ \t]*(?:[NSEW]|NE|NW|SE|SW)\b\.?`)
+	addressUnitRegex        = regexp.MustCompile(`^(?:(?i:apartment|apt|suite|ste|unit|floor|fl|room|rm|building|bldg)(?:\.?\s*#\s*|\.\s*|\s+)|#\s*)[A-Za-z\d-]+\b`)
+	addressCountryRegex     = regexp.MustCompile(`^(?i:united states of america|united states|usa|u\.s\.a|canada|united kingdom|uk|u\.k|great britain|england|scotland|wales|northern ireland)\b\.?`)
+	addressSkipRegex        = regexp.MustCompile(`^[\s,]*`)
+)
+
+// addressLocality is how the line with the city and postal code of an
+// address is written in a country. The submatches of regex are the city, the
+// state or county, and the postal code.
+type addressLocality struct {
+	country string
+	regex   *regexp.Regexp
+	// validState, if set, rejects the states the country does not have
+	validState func(string) bool
+}
+
+var addressLocalities = []addressLocality{
+	{"US", regexp.MustCompile(`^(` + addressCityPattern + `)` + addressSeparatorPattern +
+		`([A-Z]{2})\.?` + addressSeparatorPattern + `(` + ZipCodePattern + `)`), validUSState},
+	{"CA", regexp.MustCompile(`^(` + addressCityPattern + `)` + addressSeparatorPattern +
+		`(AB|BC|MB|NB|NL|NS|NT|NU|ON|PE|QC|SK|YT)\.?` + addressSeparatorPattern + `\b(` + canadianPostalCodePattern + `)\b`), nil},
+	{"GB", regexp.MustCompile(`^(` + addressCityPattern + `)(?:(?:,\s*|[ \t]*\n\s*)(` + addressCityPattern + `))?` +
+		addressSeparatorPattern + `\b(` + ukPostcodePattern + `)\b`), nil},
+}
+
+// validUSState reports whether state is the postal code of a US state,
+// territory or military mail region
+func validUSState(state string) bool {
+	zip3StatesOnce.Do(loadZIP3States)
+	for _, s := range zip3States {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// addressLines is the most lines an address may span after its street line
+const addressLines = 4
+
+// addressBlockEnd returns where an address starting at start must end: at
+// a blank line or after addressLines more lines
+func addressBlockEnd(text string, start int) int {
+	end := start
+	for n := 0; n <= addressLines; n++ {
+		i := strings.IndexByte(text[end:], '\n')
+		if i < 0 {
+			return len(text)
+		}
+		next := end + i + 1
+		if strings.TrimSpace(text[next:next+strings.IndexByte(text[next:]+"\n", '\n')]) == "" {
+			return end + i
+		}
+		end = next
+	}
+	return end
+}
+
+// parseAddress parses the address whose street line, matched by
+// StreetAddressRegex or PoBoxRegex, is text[start:streetEnd]
+func parseAddress(text string, start, streetEnd int) (Address, bool) {
+	block := text[:addressBlockEnd(text, start)]
+	a := Address{Start: start}
+	pos := streetEnd
+	if pos > len(block) {
+		// the street line took the line break before a blank line
+		pos = len(block)
+	}
+	if loc := addressDirectionalRegex.FindStringIndex(block[pos:]); loc != nil {
+		pos += loc[1]
+	}
+	a.Street = strings.TrimRight(block[start:pos], " \t\r\n,")
+
+	skip := func() int {
+		return pos + len(addressSkipRegex.FindString(block[pos:]))
+	}
+	next := skip()
+	if loc := addressUnitRegex.FindStringIndex(block[next:]); loc != nil {
+		a.Unit = block[next : next+loc[1]]
+		pos = next + loc[1]
+		next = skip()
+	}
+
+	found := false
+	for _, locality := range addressLocalities {
+		sub := locality.regex.FindStringSubmatchIndex(block[next:])
+		if sub == nil {
+			continue
+		}
+		state := ""
+		if sub[4] >= 0 {
+			state = block[next+sub[4] : next+sub[5]]
+		}
+		if locality.validState != nil && !locality.validState(state) {
+			continue
+		}
+		a.City = strings.TrimSpace(block[next+sub[2] : next+sub[3]])
+		a.State = strings.TrimSpace(state)
+		a.PostalCode = block[next+sub[6] : next+sub[7]]
+		a.Country = locality.country
+		pos = next + sub[1]
+		found = true
+		break
+	}
+	if !found {
+		return Address{}, false
+	}
+
+	next = skip()
+	if loc := addressCountryRegex.FindStringIndex(block[next:]); loc != nil {
+		pos = next + loc[1]
+	}
+	a.End = pos
+	return a, true
+}
+
+// FindAddresses finds the US, Canadian and UK postal addresses in text, in
+// the order they appear. An address starts with a street line, found by
+// StreetAddressRegex, or a post office box, found by PoBoxRegex. It may go on
+// with a unit, and must go on with a city, state or province and postal code
+// and may end with a country, on the same line or on the lines that follow up
+// to a blank line. The Limit, Unique, CaseFold, SortByFrequency, Normalized
+// and Deobfuscated options apply to the text of the addresses.
+func FindAddresses(text string, opts ...Option) []Address {
+	o := newOptions(opts)
+	m := o.prepare(text)
+	prepared := m.String()
+
+	locs := append(StreetAddressRegex.FindAllStringIndex(prepared, -1), PoBoxRegex.FindAllStringIndex(prepared, -1)...)
+	sort.SliceStable(locs, func(i, j int) bool {
+		return locs[i][0] < locs[j][0]
+	})
+
+	var addresses []Address
+	var values []string
+	end := 0
+	for _, loc := range locs {
+		if loc[0] < end || loc[0] > 0 && isWordByte(prepared[loc[0]-1]) ||
+			loc[1] < len(prepared) && isWordByte(prepared[loc[1]-1]) && isWordByte(prepared[loc[1]]) ||
+			strings.IndexByte(strings.TrimSpace(prepared[loc[0]:loc[1]]), '\n') >= 0 {
+			continue
+		}
+		a, ok := parseAddress(prepared, loc[0], loc[1])
+		if !ok {
+			continue
+		}
+		end = a.End
+		values = append(values, prepared[a.Start:a.End])
+		a.Start, a.End = m.span(a.Start, a.End)
+		addresses = append(addresses, a)
+		if o.limit > 0 && !o.unique && len(addresses) == o.limit {
+			break
+		}
+	}
+
+	return keepFound(o, addresses, values)
+}
+
+// Addresses returns the text of the postal addresses in text, like
+// FindAddresses
+func Addresses(text string, opts ...Option) []string {
+	var values []string
+	for _, a := range FindAddresses(text, opts...) {
+		values = append(values, text[a.Start:a.End])
+	}
+	return values
+}
@@ -0,0 +1,104 @@
+package commonregex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddress_FindAddresses(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := `Ship to:
+John Smith
+123 Main Street
+Suite 400
+Springfield, IL 62704
+USA
+
+Bill to PO Box 42, Anytown NY 12345-6789. Our office is at 1600 Pennsylvania
+Avenue NW, Washington, DC 20500.
+
+10 Downing Street
+London
+SW1A 2AA
+United Kingdom
+
+42 Wellington St. W, Toronto, ON M5V 3L9, Canada
+12 Trumpington Street, Cambridge, Cambridgeshire CB2 1RB`
+
+	tests := []struct {
+		address Address
+		text    string
+	}{
+		{
+			Address{Street: "123 Main Street", Unit: "Suite 400", City: "Springfield", State: "IL", PostalCode: "62704", Country: "US"},
+			"123 Main Street\nSuite 400\nSpringfield, IL 62704\nUSA",
+		},
+		{
+			Address{Street: "PO Box 42", City: "Anytown", State: "NY", PostalCode: "12345-6789", Country: "US"},
+			"PO Box 42, Anytown NY 12345-6789",
+		},
+		{
+			Address{Street: "10 Downing Street", City: "London", PostalCode: "SW1A 2AA", Country: "GB"},
+			"10 Downing Street\nLondon\nSW1A 2AA\nUnited Kingdom",
+		},
+		{
+			Address{Street: "42 Wellington St. W", City: "Toronto", State: "ON", PostalCode: "M5V 3L9", Country: "CA"},
+			"42 Wellington St. W, Toronto, ON M5V 3L9, Canada",
+		},
+		{
+			Address{Street: "12 Trumpington Street", City: "Cambridge", State: "Cambridgeshire", PostalCode: "CB2 1RB", Country: "GB"},
+			"12 Trumpington Street, Cambridge, Cambridgeshire CB2 1RB",
+		},
+	}
+
+	found := FindAddresses(text)
+	if assert.Len(found, len(tests)) {
+		for i, test := range tests {
+			assert.Equal(test.text, text[found[i].Start:found[i].End])
+			found[i].Start, found[i].End = 0, 0
+			assert.Equal(test.address, found[i])
+		}
+	}
+
+	assert.Len(FindAddresses(text, Limit(2)), 2)
+	assert.Len(FindAddresses(text+"\n\n"+text, Unique()), len(tests))
+}
+
+func TestAddress_Addresses(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"500 elm street apt 5b, Portland, OR 97205",
+		"500 Elm Street, Apt. #5, Portland, OR 97205",
+		"1 Infinite Loop\nCupertino CA 95014",
+		"PO Box 1234\nNew York, NY 10001",
+		"221 Queen St E\nToronto ON M5A 1S2",
+		"12 High Road\nSomewhere\nBT1 1AA",
+		"7 Quay Street\nNewcastle upon Tyne\nNE1 3RE",
+		"9 Sherman Avenue, Coeur d'Alene, ID 83814",
+	}
+
+	failingTests := []string{
+		"123 Main Street",
+		"123 Main Street, Springfield, ZZ 62704",
+		"123 Main Street\n\nSpringfield, IL 62704",
+		"Springfield, IL 62704",
+		"123 Main Street, Toronto, XX M5V 3L9",
+		"We sold 10 apples on the Fourth Street market in Boston, MA 02110.",
+		"I saw 4 players at the park in Dallas, TX 75201",
+		"Buy 2 display cases in Austin, TX 78701",
+		"3 people will stay in Reno, NV 89501",
+	}
+
+	for _, test := range tests {
+		assert.Equal([]string{test}, Addresses(test), "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(Addresses(test), "%s should not be matched", test)
+	}
+}
@@ -0,0 +1,65 @@
+package commonregex
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// corpusSize is the size every corpus is repeated up to, so that the
+// benchmarks measure scanning rather than per-call overhead
+const corpusSize = 64 << 10
+
+var corpora = []string{"access.log", "email.txt", "page.html"}
+
+func loadCorpus(b *testing.B, name string) string {
+	data, err := os.ReadFile(filepath.Join("testdata", "corpus", name))
+	if err != nil {
+		b.Fatal(err)
+	}
+	return strings.Repeat(string(data), corpusSize/len(data)+1)[:corpusSize]
+}
+
+func BenchmarkFinders(b *testing.B) {
+	for _, name := range corpora {
+		text := loadCorpus(b, name)
+		for _, f := range finders {
+			f := f
+			b.Run(name+"/"+f.name, func(b *testing.B) {
+				b.ReportAllocs()
+				b.SetBytes(int64(len(text)))
+				for i := 0; i < b.N; i++ {
+					f.find(text)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkScan(b *testing.B) {
+	for _, name := range corpora {
+		text := loadCorpus(b, name)
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(text)))
+			for i := 0; i < b.N; i++ {
+				Scan(text)
+			}
+		})
+	}
+}
+
+func BenchmarkScanConcurrent(b *testing.B) {
+	for _, name := range corpora {
+		text := strings.Repeat(loadCorpus(b, name), 16)
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(text)))
+			for i := 0; i < b.N; i++ {
+				ScanConcurrent(context.Background(), text)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package commonregex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// budgetChunkSize is the size of the chunks ScanContext scans at a time. The
+// context and the time budget are checked before each pattern runs on a chunk.
+const budgetChunkSize = 64 << 10
+
+// ErrBudgetExceeded is matched by the errors returned when a scan runs over
+// its Budget, so errors.Is(err, ErrBudgetExceeded) tells those apart.
+var ErrBudgetExceeded = errors.New("commonregex: budget exceeded")
+
+// BudgetLimit names one of the limits of a Budget
+type BudgetLimit string
+
+// Limits of a Budget
+const (
+	LimitInputSize BudgetLimit = "input size"
+	LimitMatches   BudgetLimit = "matches per kind"
+	LimitTimeout   BudgetLimit = "timeout"
+)
+
+// Budget bounds the work a scan of untrusted input may do. Zero fields mean no
+// limit.
+type Budget struct {
+	// MaxInputSize is the number of bytes scanned. Longer texts are cut at a
+	// line break or whitespace before the limit.
+	MaxInputSize int
+	// MaxMatchesPerKind is the number of matches returned for each kind.
+	MaxMatchesPerKind int
+	// Timeout is the wall-clock time the scan may take.
+	Timeout time.Duration
+}
+
+// BudgetError reports which limit of a Budget a scan exceeded. It matches
+// ErrBudgetExceeded with errors.Is.
+type BudgetError struct {
+	Limit BudgetLimit
+	// Kind is the kind which had too many matches for LimitMatches
+	Kind Kind
+}
+
+func (e *BudgetError) Error() string {
+	if e.Kind != "" {
+		return fmt.Sprintf("%v: %s of %s", ErrBudgetExceeded, e.Limit, e.Kind)
+	}
+	return fmt.Sprintf("%v: %s", ErrBudgetExceeded, e.Limit)
+}
+
+// Is reports whether target is ErrBudgetExceeded
+func (e *BudgetError) Is(target error) bool {
+	return target == ErrBudgetExceeded
+}
+
+// ScanContext finds the matches of the given kinds, or of every built-in kind
+// if none are given, like Scan, but stops once ctx is done or the budget is
+// exceeded. The text is scanned in chunks and ctx is checked before each
+// pattern runs on a chunk.
+//
+// When the scan is cut short, ScanContext returns the matches found so far in
+// offset order along with the error: ctx.Err() if ctx is done, or a
+// *BudgetError if a limit of the budget was hit. A kind over
+// MaxMatchesPerKind keeps its first matches while the other kinds are still
+// scanned.
+func ScanContext(ctx context.Context, text string, budget Budget, kinds ...Kind) ([]Match, error) {
+	var budgetErr error
+	if budget.MaxInputSize > 0 && len(text) > budget.MaxInputSize {
+		text = text[:safeBoundary(text, budget.MaxInputSize, 0)]
+		budgetErr = &BudgetError{Limit: LimitInputSize}
+	}
+
+	scanCtx := ctx
+	if budget.Timeout > 0 {
+		var cancel context.CancelFunc
+		scanCtx, cancel = context.WithTimeout(ctx, budget.Timeout)
+		defer cancel()
+	}
+
+	var merged []Match
+	infos := selectKinds(kinds)
+	counts := make(map[Kind]int)
+	ends := make(map[Kind]int)
+	exceeded := make(map[Kind]bool)
+	for _, c := range splitChunks(text, budgetChunkSize, concurrentOverlap) {
+		var found []Match
+		for _, info := range infos {
+			if scanCtx.Err() != nil {
+				sortMatches(found)
+				merged = append(merged, found...)
+				if err := ctx.Err(); err != nil {
+					return merged, err
+				}
+				return merged, &BudgetError{Limit: LimitTimeout}
+			}
+			if exceeded[info.kind] {
+				continue
+			}
+			for _, match := range findMatches(text, c.start, c.limit, info) {
+				if match.Start >= c.end {
+					break
+				}
+				if match.Start < ends[info.kind] {
+					continue
+				}
+				if budget.MaxMatchesPerKind > 0 && counts[info.kind] == budget.MaxMatchesPerKind {
+					exceeded[info.kind] = true
+					if budgetErr == nil {
+						budgetErr = &BudgetError{Limit: LimitMatches, Kind: info.kind}
+					}
+					break
+				}
+				found = append(found, match)
+				counts[info.kind]++
+				ends[info.kind] = match.End
+			}
+		}
+		sortMatches(found)
+		merged = append(merged, found...)
+	}
+	return merged, budgetErr
+}
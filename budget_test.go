@@ -0,0 +1,85 @@
+package commonregex
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudget_ScanContext(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := strings.Repeat("mail john@example.com from 10.0.0.1 at 9:45\n", 5000)
+
+	matches, err := ScanContext(context.Background(), text, Budget{}, KindEmail, KindIPv4)
+	assert.NoError(err)
+	assert.Equal(Scan(text, KindEmail, KindIPv4), matches)
+}
+
+func TestBudget_MaxInputSize(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := "john@example.com jane@example.com bob@example.com"
+	matches, err := ScanContext(context.Background(), text, Budget{MaxInputSize: 40}, KindEmail)
+	assert.True(errors.Is(err, ErrBudgetExceeded))
+	assert.Equal(&BudgetError{Limit: LimitInputSize}, err)
+	assert.Equal([]Match{
+		{Kind: KindEmail, Value: "john@example.com", Start: 0, End: 16},
+		{Kind: KindEmail, Value: "jane@example.com", Start: 17, End: 33},
+	}, matches)
+}
+
+func TestBudget_MaxMatchesPerKind(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := strings.Repeat("john@example.com 10.0.0.1\n", 100000)
+	matches, err := ScanContext(context.Background(), text, Budget{MaxMatchesPerKind: 3}, KindEmail)
+	assert.True(errors.Is(err, ErrBudgetExceeded))
+	assert.Equal(&BudgetError{Limit: LimitMatches, Kind: KindEmail}, err)
+	assert.Len(matches, 3)
+
+	matches, err = ScanContext(context.Background(), "john@example.com 10.0.0.1", Budget{MaxMatchesPerKind: 1}, KindEmail, KindIPv4)
+	assert.NoError(err)
+	assert.Len(matches, 2)
+}
+
+func TestBudget_Timeout(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := strings.Repeat("Mar 23 2017 10.0.0.1 fe80::1 ", 200000)
+	start := time.Now()
+	matches, err := ScanContext(context.Background(), text, Budget{Timeout: 50 * time.Millisecond})
+	assert.True(time.Since(start) < 5*time.Second, "the scan should stop early")
+	assert.Equal(&BudgetError{Limit: LimitTimeout}, err)
+	for _, match := range matches {
+		assert.Equal(text[match.Start:match.End], match.Value)
+	}
+}
+
+func TestBudget_Canceled(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	matches, err := ScanContext(ctx, "john@example.com", Budget{Timeout: time.Second})
+	assert.Equal(context.Canceled, err)
+	assert.Empty(matches)
+	assert.False(errors.Is(err, ErrBudgetExceeded))
+}
+
+func TestBudget_Error(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	assert.Equal("commonregex: budget exceeded: timeout", (&BudgetError{Limit: LimitTimeout}).Error())
+	assert.Equal("commonregex: budget exceeded: matches per kind of email", (&BudgetError{Limit: LimitMatches, Kind: KindEmail}).Error())
+}
@@ -4,30 +4,57 @@ import "regexp"
 
 // Regular expression patterns
 const (
-	DatePattern           = `(?i)(?:[0-3]?\d(?:st|nd|rd|th)?\s+(?:of\s+)?(?:jan\.?|january|feb\.?|february|mar\.?|march|apr\.?|april|may|jun\.?|june|jul\.?|july|aug\.?|august|sep\.?|september|oct\.?|october|nov\.?|november|dec\.?|december)|(?:jan\.?|january|feb\.?|february|mar\.?|march|apr\.?|april|may|jun\.?|june|jul\.?|july|aug\.?|august|sep\.?|september|oct\.?|october|nov\.?|november|dec\.?|december)\s+[0-3]?\d(?:st|nd|rd|th)?)(?:\,)?\s*(?:\d{4})?|[0-3]?\d[-\./][0-3]?\d[-\./]\d{2,4}`
-	TimePattern           = `(?i)\d{1,2}:\d{2} ?(?:[ap]\.?m\.?)?|\d[ap]\.?m\.?`
+	DatePattern           = `(?i)(?:[0-3]?\d(?:st|nd|rd|th)?\s+(?:of\s+)?(?:jan\.?|january|feb\.?|february|mar\.?|march|apr\.?|april|may|jun\.?|june|jul\.?|july|aug\.?|august|sep\.?|september|oct\.?|october|nov\.?|november|dec\.?|december)|(?:jan\.?|january|feb\.?|february|mar\.?|march|apr\.?|april|may|jun\.?|june|jul\.?|july|aug\.?|august|sep\.?|september|oct\.?|october|nov\.?|november|dec\.?|december)\s+[0-3]?\d(?:st|nd|rd|th)?)(?:\,)?\s*(?:\d{4})?|\b[0-3]?\d[-\./][0-3]?\d[-\./]\d{2,4}|\b\d{4}[-\./](?:0?[1-9]|1[0-2])[-\./](?:3[01]|[12]\d|0?[1-9])`
+	TimePattern           = `(?i)\b(?:\d{1,2}:\d{2}(?::\d{2}(?:[.,]\d{1,9})?(?: ?[+-](?:[01]\d|2[0-3]):?[0-5]\d\b)?)? ?(?:[ap]\.?m\.?)?(?-i:Z\b| ?(?:UTC|GMT|[ECMP][SD]T|AK[SD]T|HST|BST|IST|CES?T|EES?T|WES?T|MSK|JST|KST|AE[SD]T|ACST|AWST|NZ[SD]T|SGT|HKT)\b)?|\d{1,2}[ap]\.?m\.?)`
+	TimestampPattern      = `\b\d{4}-(?:0[1-9]|1[0-2])-(?:0[1-9]|[12]\d|3[01])[T ](?:[01]\d|2[0-3]):[0-5]\d(?::[0-5]\d(?:[.,]\d{1,9})?)?(?:Z| ?[+-](?:[01]\d|2[0-3]):?[0-5]\d| ?(?:UTC|GMT|[ECMP][SD]T|AK[SD]T|HST|BST|IST|CES?T|EES?T|WES?T|MSK|JST|KST|AE[SD]T|ACST|AWST|NZ[SD]T|SGT|HKT))?\b|\b(?:(?:Mon|Tue|Wed|Thu|Fri|Sat|Sun) )?(?:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec) (?: [1-9]|0[1-9]|[12]\d|3[01]|[1-9]) (?:[01]\d|2[0-3]):[0-5]\d:[0-5]\d(?:\.\d{1,6})?(?: (?:UTC|GMT|[ECMP][SD]T|AK[SD]T|HST|BST|IST|CES?T|EES?T|WES?T|MSK|JST|KST|AE[SD]T|ACST|AWST|NZ[SD]T|SGT|HKT))?(?: \d{4})?\b|\[(?:0[1-9]|[12]\d|3[01])/(?:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)/\d{4}:(?:[01]\d|2[0-3]):[0-5]\d:[0-5]\d [+-](?:[01]\d|2[0-3])[0-5]\d\]`
+	EpochTimePattern      = `\b1\d{9}(?:\d{3}|\.\d{1,6})?\b`
 	PhonePattern          = `(?:(?:\+?\d{1,3}[-.\s*]?)?(?:\(?\d{3}\)?[-.\s*]?)?\d{3}[-.\s*]?\d{4,6})|(?:(?:(?:\(\+?\d{2}\))|(?:\+?\d{2}))\s*\d{2}\s*\d{3}\s*\d{4})`
 	PhonesWithExtsPattern = `(?i)(?:(?:\+?1\s*(?:[.-]\s*)?)?(?:\(\s*(?:[2-9]1[02-9]|[2-9][02-8]1|[2-9][02-8][02-9])\s*\)|(?:[2-9]1[02-9]|[2-9][02-8]1|[2-9][02-8][02-9]))\s*(?:[.-]\s*)?)?(?:[2-9]1[02-9]|[2-9][02-9]1|[2-9][02-9]{2})\s*(?:[.-]\s*)?(?:[0-9]{4})(?:\s*(?:#|x\.?|ext\.?|extension)\s*(?:\d+)?)`
 	LinkPattern           = `(?:(?:https?:\/\/)?(?:[a-z0-9.\-]+|www|[a-z0-9.\-])[.](?:[^\s()<>]+|\((?:[^\s()<>]+|(?:\([^\s()<>]+\)))*\))+(?:\((?:[^\s()<>]+|(?:\([^\s()<>]+\)))*\)|[^\s!()\[\]{};:\'".,<>?]))`
 	EmailPattern          = `(?i)([A-Za-z0-9!#$%&'*+\/=?^_{|.}~-]+@(?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\.)+[a-z0-9](?:[a-z0-9-]*[a-z0-9])?)`
-	IPv4Pattern           = `(?:(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)`
+	IPv4Pattern           = `\b(?:(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\b`
 	IPv6Pattern           = `(?:(?:(?:[0-9A-Fa-f]{1,4}:){7}(?:[0-9A-Fa-f]{1,4}|:))|(?:(?:[0-9A-Fa-f]{1,4}:){6}(?::[0-9A-Fa-f]{1,4}|(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(?:\.(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3})|:))|(?:(?:[0-9A-Fa-f]{1,4}:){5}(?:(?:(?::[0-9A-Fa-f]{1,4}){1,2})|:(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(?:\.(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3})|:))|(?:(?:[0-9A-Fa-f]{1,4}:){4}(?:(?:(?::[0-9A-Fa-f]{1,4}){1,3})|(?:(?::[0-9A-Fa-f]{1,4})?:(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(?:\.(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3}))|:))|(?:(?:[0-9A-Fa-f]{1,4}:){3}(?:(?:(?::[0-9A-Fa-f]{1,4}){1,4})|(?:(?::[0-9A-Fa-f]{1,4}){0,2}:(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(?:\.(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3}))|:))|(?:(?:[0-9A-Fa-f]{1,4}:){2}(?:(?:(?::[0-9A-Fa-f]{1,4}){1,5})|(?:(?::[0-9A-Fa-f]{1,4}){0,3}:(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(?:\.(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3}))|:))|(?:(?:[0-9A-Fa-f]{1,4}:){1}(?:(?:(?::[0-9A-Fa-f]{1,4}){1,6})|(?:(?::[0-9A-Fa-f]{1,4}){0,4}:(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(?:\.(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3}))|:))|(?::(?:(?:(?::[0-9A-Fa-f]{1,4}){1,7})|(?:(?::[0-9A-Fa-f]{1,4}){0,5}:(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(?:\.(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3}))|:)))(?:%.+)?\s*`
 	IPPattern             = IPv4Pattern + `|` + IPv6Pattern
 	NotKnownPortPattern   = `6[0-5]{2}[0-3][0-5]|[1-5][\d]{4}|[2-9][\d]{3}|1[1-9][\d]{2}|10[3-9][\d]|102[4-9]`
-	PricePattern          = `[$]\s?[+-]?[0-9]{1,3}(?:(?:,?[0-9]{3}))*(?:\.[0-9]{1,2})?`
+	PricePattern          = `\((?:` + priceForms + `)\)|-?(?:` + priceForms + `)`
 	HexColorPattern       = `(?:#?([0-9a-fA-F]{6}|[0-9a-fA-F]{3}))`
 	CreditCardPattern     = `(?:(?:(?:\d{4}[- ]?){3}\d{4}|\d{15,16}))`
 	VISACreditCardPattern = `4\d{3}[\s-]?\d{4}[\s-]?\d{4}[\s-]?\d{4}`
 	MCCreditCardPattern   = `5[1-5]\d{2}[\s-]?\d{4}[\s-]?\d{4}[\s-]?\d{4}`
 	BtcAddressPattern     = `[13][a-km-zA-HJ-NP-Z1-9]{25,34}`
-	StreetAddressPattern  = `\d{1,4} [\w\s]{1,20}(?:street|st|avenue|ave|road|rd|highway|hwy|square|sq|trail|trl|drive|dr|court|ct|park|parkway|pkwy|circle|cir|boulevard|blvd)\W?`
+	EthAddressPattern     = `\b0x[0-9a-fA-F]{40}\b`
+	LtcAddressPattern     = `\b(?:[LM][1-9A-HJ-NP-Za-km-z]{26,33}|ltc1[02-9ac-hj-np-z]{39,59})\b`
+	BchAddressPattern     = `\b(?:bitcoincash:)?[qp][02-9ac-hj-np-z]{41}\b`
+	DogeAddressPattern    = `\bD[5-9A-HJ-NP-U][1-9A-HJ-NP-Za-km-z]{32}\b`
+	XmrAddressPattern     = `\b[48][1-9A-HJ-NP-Za-km-z]{94}(?:[1-9A-HJ-NP-Za-km-z]{11})?\b`
+	SolAddressPattern     = `\b[1-9A-HJ-NP-Za-km-z]{32,44}\b`
+	TrxAddressPattern     = `\bT[1-9A-HJ-NP-Za-km-z]{33}\b`
+	XrpAddressPattern     = `\br[1-9A-HJ-NP-Za-km-z]{24,34}\b`
+	StreetAddressPattern  = `(?i)\d{1,4} [\w\s]{1,20}(?:street|st|avenue|ave|road|rd|highway|hwy|square|sq|trail|trl|drive|dr|court|ct|park|parkway|pkwy|circle|cir|boulevard|blvd|lane|ln|way|place|pl|terrace|loop)\b\W?`
 	ZipCodePattern        = `\b\d{5}(?:[-\s]\d{4})?\b`
 	PoBoxPattern          = `(?i)P\.? ?O\.? Box \d+`
-	SSNPattern            = `(?:\d{3}-\d{2}-\d{4})`
-	MD5HexPattern         = `[0-9a-fA-F]{32}`
-	SHA1HexPattern        = `[0-9a-fA-F]{40}`
-	SHA256HexPattern      = `[0-9a-fA-F]{64}`
-	GUIDPattern           = `[0-9a-fA-F]{8}-?[a-fA-F0-9]{4}-?[a-fA-F0-9]{4}-?[a-fA-F0-9]{4}-?[a-fA-F0-9]{12}`
+	SSNPattern            = `\b(?:00[1-9]|0[1-9]\d|[1-578]\d{2}|6[0-57-9]\d|66[0-57-9])-(?:0[1-9]|[1-9]\d)-(?:000[1-9]|00[1-9]\d|0[1-9]\d{2}|[1-9]\d{3})\b`
+	NINOPattern           = `\b[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z] ?\d{2} ?\d{2} ?\d{2} ?[A-D]\b`
+	SINPattern            = `\b[1-79]\d{2}[- ]?\d{3}[- ]?\d{3}\b`
+	NIRPattern            = `\b[1-478] ?\d{2} ?(?:0[1-9]|1[0-2]|[2-9]\d) ?(?:\d{2}|2[AB]) ?\d{3} ?\d{3} ?\d{2}\b`
+	SteuerIDPattern       = `\b[1-9]\d ?\d{3} ?\d{3} ?\d{3}\b`
+	FiscalCodePattern     = `\b[A-Z]{6}[\dLMNP-V]{2}[A-EHLMPR-T][\dLMNP-V]{2}[A-Z][\dLMNP-V]{3}[A-Z]\b`
+	DNIPattern            = `\b\d{8}-?[A-HJ-NP-TV-Z]\b`
+	NIEPattern            = `\b[XYZ]-?\d{7}-?[A-HJ-NP-TV-Z]\b`
+	BSNPattern            = `\b\d{4}\.?\d{2}\.?\d{3}\b`
+	AadhaarPattern        = `\b[2-9]\d{3}[ -]?\d{4}[ -]?\d{4}\b`
+	PANPattern            = `\b[A-Z]{3}[ABCFGHJLPT][A-Z]\d{4}[A-Z]\b`
+	CPFPattern            = `\b\d{3}\.?\d{3}\.?\d{3}-?\d{2}\b`
+	CNPJPattern           = `\b\d{2}\.?\d{3}\.?\d{3}/?\d{4}-?\d{2}\b`
+	RRNPattern            = `\b\d{2}(?:0[1-9]|1[0-2])(?:0[1-9]|[12]\d|3[01])-?[1-8]\d{6}\b`
+	PassportPattern       = `\b(?:[A-Z]\d{8}|\d{9})\b`
+	DriversLicensePattern = `\b(?:[A-Z]\d{3,18}|[A-Z]{2}\d{2,7}|[A-Z]{3}\d{6}|[A-Z]{2}\d{6}[A-Z]|\d{3}[A-Z]{2}\d{4}|\d{2}[A-Z]{3}\d{5}|\d{7,9}[A-Z]{1,2}|[A-Z]\d{6}R|[A-Z]\d[A-Z]\d[A-Z]|WDL[A-Z\d]{9}|[A-Z][A-Z*]{6}[A-Z\d*]{4}[A-Z\d]|\d{4,16})\b`
+	EINPattern            = `\b\d{2}-\d{7}\b`
+	ITINPattern           = `\b9\d{2}-?(?:5\d|6[0-5]|7\d|8[0-8]|9[0-24-9])-?\d{4}\b`
+	MD5HexPattern         = `\b[0-9a-fA-F]{32}\b`
+	SHA1HexPattern        = `\b[0-9a-fA-F]{40}\b`
+	SHA256HexPattern      = `\b[0-9a-fA-F]{64}\b`
+	GUIDPattern           = `\b[0-9a-fA-F]{8}-?[a-fA-F0-9]{4}-?[a-fA-F0-9]{4}-?[a-fA-F0-9]{4}-?[a-fA-F0-9]{12}\b`
 	ISBN13Pattern         = `(?:[\d]-?){12}[\dxX]`
 	ISBN10Pattern         = `(?:[\d]-?){9}[\dxX]`
 	MACAddressPattern     = `(([a-fA-F0-9]{2}[:-]){5}([a-fA-F0-9]{2}))`
@@ -35,10 +62,24 @@ const (
 	GitRepoPattern        = `((git|ssh|http(s)?)|(git@[\w\.]+))(:(\/\/)?)([\w\.@\:/\-~]+)(\.git)(\/)?`
 )
 
+// Keywords that must appear near a match of the context-gated patterns:
+// PassportPattern, DriversLicensePattern, EINPattern, ITINPattern,
+// EpochTimePattern and SolAddressPattern are too generic to match on their own
+const (
+	PassportContextPattern       = `(?i)\bpassports?\b`
+	DriversLicenseContextPattern = `\b(?:(?:DLN?|D/L|(?i:driver(?:'|\x{2019})?s?\s+licen[cs]es?|driving\s+licen[cs]es?|licen[cs]e\s*(?:no|number)))\b|(?i:licen[cs]e)\s*#)`
+	EINContextPattern            = `\b(?:EIN|FEIN|TIN|(?i:employer\s+identification|employer\s+ID|tax\s+ID))\b`
+	ITINContextPattern           = `\b(?:ITIN|TIN|(?i:individual\s+taxpayer|taxpayer\s+identification|tax\s+ID))\b`
+	EpochTimeContextPattern      = `(?i)\b(?:epoch|unix|timestamps?|ts|time|created|updated|modified|expires|iat|exp|nbf)\b|_(?:at|on|ts|ms|time)\b`
+	SolAddressContextPattern     = `(?i)\b(?:solana|sol|spl|lamports?|wallet)\b`
+)
+
 // Compiled regular expressions
 var (
 	DateRegex           = regexp.MustCompile(DatePattern)
 	TimeRegex           = regexp.MustCompile(TimePattern)
+	TimestampRegex      = regexp.MustCompile(TimestampPattern)
+	EpochTimeRegex      = regexp.MustCompile(EpochTimePattern)
 	PhoneRegex          = regexp.MustCompile(PhonePattern)
 	PhonesWithExtsRegex = regexp.MustCompile(PhonesWithExtsPattern)
 	LinkRegex           = regexp.MustCompile(LinkPattern)
@@ -51,10 +92,35 @@ var (
 	HexColorRegex       = regexp.MustCompile(HexColorPattern)
 	CreditCardRegex     = regexp.MustCompile(CreditCardPattern)
 	BtcAddressRegex     = regexp.MustCompile(BtcAddressPattern)
+	EthAddressRegex     = regexp.MustCompile(EthAddressPattern)
+	LtcAddressRegex     = regexp.MustCompile(LtcAddressPattern)
+	BchAddressRegex     = regexp.MustCompile(BchAddressPattern)
+	DogeAddressRegex    = regexp.MustCompile(DogeAddressPattern)
+	XmrAddressRegex     = regexp.MustCompile(XmrAddressPattern)
+	SolAddressRegex     = regexp.MustCompile(SolAddressPattern)
+	TrxAddressRegex     = regexp.MustCompile(TrxAddressPattern)
+	XrpAddressRegex     = regexp.MustCompile(XrpAddressPattern)
 	StreetAddressRegex  = regexp.MustCompile(StreetAddressPattern)
 	ZipCodeRegex        = regexp.MustCompile(ZipCodePattern)
 	PoBoxRegex          = regexp.MustCompile(PoBoxPattern)
 	SSNRegex            = regexp.MustCompile(SSNPattern)
+	NINORegex           = regexp.MustCompile(NINOPattern)
+	SINRegex            = regexp.MustCompile(SINPattern)
+	NIRRegex            = regexp.MustCompile(NIRPattern)
+	SteuerIDRegex       = regexp.MustCompile(SteuerIDPattern)
+	FiscalCodeRegex     = regexp.MustCompile(FiscalCodePattern)
+	DNIRegex            = regexp.MustCompile(DNIPattern)
+	NIERegex            = regexp.MustCompile(NIEPattern)
+	BSNRegex            = regexp.MustCompile(BSNPattern)
+	AadhaarRegex        = regexp.MustCompile(AadhaarPattern)
+	PANRegex            = regexp.MustCompile(PANPattern)
+	CPFRegex            = regexp.MustCompile(CPFPattern)
+	CNPJRegex           = regexp.MustCompile(CNPJPattern)
+	RRNRegex            = regexp.MustCompile(RRNPattern)
+	PassportRegex       = regexp.MustCompile(PassportPattern)
+	DriversLicenseRegex = regexp.MustCompile(DriversLicensePattern)
+	EINRegex            = regexp.MustCompile(EINPattern)
+	ITINRegex           = regexp.MustCompile(ITINPattern)
 	MD5HexRegex         = regexp.MustCompile(MD5HexPattern)
 	SHA1HexRegex        = regexp.MustCompile(SHA1HexPattern)
 	SHA256HexRegex      = regexp.MustCompile(SHA256HexPattern)
@@ -66,154 +132,348 @@ var (
 	MACAddressRegex     = regexp.MustCompile(MACAddressPattern)
 	IBANRegex           = regexp.MustCompile(IBANPattern)
 	GitRepoRegex        = regexp.MustCompile(GitRepoPattern)
+
+	PassportContextRegex       = regexp.MustCompile(PassportContextPattern)
+	DriversLicenseContextRegex = regexp.MustCompile(DriversLicenseContextPattern)
+	EINContextRegex            = regexp.MustCompile(EINContextPattern)
+	ITINContextRegex           = regexp.MustCompile(ITINContextPattern)
+	EpochTimeContextRegex      = regexp.MustCompile(EpochTimeContextPattern)
+	SolAddressContextRegex     = regexp.MustCompile(SolAddressContextPattern)
 )
 
-func match(text string, regex *regexp.Regexp) []string {
-	parsed := regex.FindAllString(text, -1)
-	return parsed
+func match(text string, kind Kind, opts []Option) []string {
+	o := newOptions(opts)
+	if o.normalize {
+		text = Normalize(text)
+	}
+	if o.deobfuscate {
+		text = Deobfuscate(text)
+	}
+	info, _ := lookupKind(kind)
+	n := -1
+	if o.limit > 0 && !o.unique {
+		n = o.limit
+	}
+	locs := info.findAllIndex(text, n)
+	if locs == nil {
+		return nil
+	}
+	parsed := make([]string, len(locs))
+	for i, loc := range locs {
+		parsed[i] = text[loc[0]:loc[1]]
+	}
+	return o.apply(parsed)
 }
 
-// Date finds all date strings
-func Date(text string) []string {
-	return match(text, DateRegex)
+// Date finds all date strings. Dates are written as in English unless
+// another locale is given with InLocale, as ParseDates describes.
+func Date(text string, opts ...Option) []string {
+	o := newOptions(opts)
+	if lookupDateLocale(o.locale).regex == nil {
+		return match(text, KindDate, opts)
+	}
+	prepared := o.prepare(text).String()
+	var dates []string
+	for _, loc := range findDates(prepared, o.locale) {
+		dates = append(dates, prepared[loc[0]:loc[1]])
+	}
+	return o.apply(dates)
 }
 
 // Time finds all time strings
-func Time(text string) []string {
-	return match(text, TimeRegex)
+func Time(text string, opts ...Option) []string {
+	return match(text, KindTime, opts)
+}
+
+// Timestamps finds all log timestamps: ISO 8601 and RFC 3339 date-times,
+// syslog stamps like "Mar 23 09:45:00" and Apache stamps like
+// "[23/Mar/2017:09:45:00 +0000]"
+func Timestamps(text string, opts ...Option) []string {
+	return match(text, KindTimestamp, opts)
+}
+
+// EpochTimes finds all Unix times in seconds or milliseconds, from 2001 to
+// 2033, with a keyword such as "timestamp" or "created_at" nearby
+func EpochTimes(text string, opts ...Option) []string {
+	return match(text, KindEpochTime, opts)
 }
 
 // Phones finds all phone numbers
-func Phones(text string) []string {
-	return match(text, PhoneRegex)
+func Phones(text string, opts ...Option) []string {
+	return match(text, KindPhone, opts)
 }
 
 // PhonesWithExts finds all phone numbers with ext
-func PhonesWithExts(text string) []string {
-	return match(text, PhonesWithExtsRegex)
+func PhonesWithExts(text string, opts ...Option) []string {
+	return match(text, KindPhoneWithExt, opts)
 }
 
 // Links finds all link strings
-func Links(text string) []string {
-	return match(text, LinkRegex)
+func Links(text string, opts ...Option) []string {
+	return match(text, KindLink, opts)
 }
 
 // Emails finds all email strings
-func Emails(text string) []string {
-	return match(text, EmailRegex)
+func Emails(text string, opts ...Option) []string {
+	return match(text, KindEmail, opts)
 }
 
 // IPv4s finds all IPv4 addresses
-func IPv4s(text string) []string {
-	return match(text, IPv4Regex)
+func IPv4s(text string, opts ...Option) []string {
+	return match(text, KindIPv4, opts)
 }
 
 // IPv6s finds all IPv6 addresses
-func IPv6s(text string) []string {
-	return match(text, IPv6Regex)
+func IPv6s(text string, opts ...Option) []string {
+	return match(text, KindIPv6, opts)
 }
 
 // IPs finds all IP addresses (both IPv4 and IPv6)
-func IPs(text string) []string {
-	return match(text, IPRegex)
+func IPs(text string, opts ...Option) []string {
+	return match(text, KindIP, opts)
 }
 
 // NotKnownPorts finds all not-known port numbers
-func NotKnownPorts(text string) []string {
-	return match(text, NotKnownPortRegex)
+func NotKnownPorts(text string, opts ...Option) []string {
+	return match(text, KindNotKnownPort, opts)
 }
 
-// Prices finds all price strings
-func Prices(text string) []string {
-	return match(text, PriceRegex)
+// Prices finds all price strings: amounts with a currency symbol or ISO 4217
+// code before or after them, as in "$1,000", "€1.200,50", "USD 1,200",
+// "1.200,50 EUR", "-£5" or "(¥1,000)", with an optional "k", "M", "mn" or "bn"
+// multiplier. Dollar amounts are read with "," thousands separators and a "."
+// before the cents, and rupees also with Indian grouping, as in "₹12,34,567".
+// An amount cut from a longer number is not taken for a price.
+func Prices(text string, opts ...Option) []string {
+	return match(text, KindPrice, opts)
 }
 
 // HexColors finds all hex color values
-func HexColors(text string) []string {
-	return match(text, HexColorRegex)
+func HexColors(text string, opts ...Option) []string {
+	return match(text, KindHexColor, opts)
 }
 
 // CreditCards finds all credit card numbers
-func CreditCards(text string) []string {
-	return match(text, CreditCardRegex)
+func CreditCards(text string, opts ...Option) []string {
+	return match(text, KindCreditCard, opts)
 }
 
 // BtcAddresses finds all bitcoin addresses
-func BtcAddresses(text string) []string {
-	return match(text, BtcAddressRegex)
+func BtcAddresses(text string, opts ...Option) []string {
+	return match(text, KindBtcAddress, opts)
+}
+
+// EthAddresses finds all Ethereum addresses with a valid checksum
+func EthAddresses(text string, opts ...Option) []string {
+	return match(text, KindEthAddress, opts)
+}
+
+// LtcAddresses finds all Litecoin addresses with a valid checksum
+func LtcAddresses(text string, opts ...Option) []string {
+	return match(text, KindLtcAddress, opts)
+}
+
+// BchAddresses finds all Bitcoin Cash CashAddr addresses with a valid checksum
+func BchAddresses(text string, opts ...Option) []string {
+	return match(text, KindBchAddress, opts)
+}
+
+// DogeAddresses finds all Dogecoin addresses with a valid checksum
+func DogeAddresses(text string, opts ...Option) []string {
+	return match(text, KindDogeAddress, opts)
+}
+
+// XmrAddresses finds all Monero addresses with a valid checksum
+func XmrAddresses(text string, opts ...Option) []string {
+	return match(text, KindXmrAddress, opts)
+}
+
+// SolAddresses finds all Solana addresses, base58 strings that decode to 32
+// bytes, with a keyword such as "solana" or "wallet" nearby
+func SolAddresses(text string, opts ...Option) []string {
+	return match(text, KindSolAddress, opts)
+}
+
+// TrxAddresses finds all Tron addresses with a valid checksum
+func TrxAddresses(text string, opts ...Option) []string {
+	return match(text, KindTrxAddress, opts)
+}
+
+// XrpAddresses finds all XRP Ledger addresses with a valid checksum
+func XrpAddresses(text string, opts ...Option) []string {
+	return match(text, KindXrpAddress, opts)
 }
 
 // StreetAddresses finds all street addresses
-func StreetAddresses(text string) []string {
-	return match(text, StreetAddressRegex)
+func StreetAddresses(text string, opts ...Option) []string {
+	return match(text, KindStreetAddress, opts)
 }
 
 // ZipCodes finds all zip codes
-func ZipCodes(text string) []string {
-	return match(text, ZipCodeRegex)
+func ZipCodes(text string, opts ...Option) []string {
+	return match(text, KindZipCode, opts)
 }
 
 // PoBoxes finds all po-box strings
-func PoBoxes(text string) []string {
-	return match(text, PoBoxRegex)
+func PoBoxes(text string, opts ...Option) []string {
+	return match(text, KindPoBox, opts)
+}
+
+// SSNs finds all US social security numbers, leaving out those with an
+// area, group or serial number the SSA never assigns and those voided after
+// appearing in advertisements
+func SSNs(text string, opts ...Option) []string {
+	return match(text, KindSSN, opts)
+}
+
+// NINOs finds all UK National Insurance numbers with an allocated prefix
+func NINOs(text string, opts ...Option) []string {
+	return match(text, KindNINO, opts)
+}
+
+// SINs finds all Canadian Social Insurance Numbers passing the Luhn check
+func SINs(text string, opts ...Option) []string {
+	return match(text, KindSIN, opts)
+}
+
+// NIRs finds all French social security numbers (INSEE/NIR) with a valid key
+func NIRs(text string, opts ...Option) []string {
+	return match(text, KindNIR, opts)
+}
+
+// SteuerIDs finds all German tax identification numbers (Steuer-ID) with a
+// valid check digit
+func SteuerIDs(text string, opts ...Option) []string {
+	return match(text, KindSteuerID, opts)
+}
+
+// FiscalCodes finds all Italian fiscal codes (codice fiscale) with a valid
+// check letter
+func FiscalCodes(text string, opts ...Option) []string {
+	return match(text, KindFiscalCode, opts)
+}
+
+// DNIs finds all Spanish national identity numbers (DNI) with a valid check
+// letter
+func DNIs(text string, opts ...Option) []string {
+	return match(text, KindDNI, opts)
+}
+
+// NIEs finds all Spanish foreigner identity numbers (NIE) with a valid check
+// letter
+func NIEs(text string, opts ...Option) []string {
+	return match(text, KindNIE, opts)
+}
+
+// BSNs finds all Dutch citizen service numbers (BSN) passing the eleven test
+func BSNs(text string, opts ...Option) []string {
+	return match(text, KindBSN, opts)
+}
+
+// Aadhaars finds all Indian Aadhaar numbers with a valid check digit
+func Aadhaars(text string, opts ...Option) []string {
+	return match(text, KindAadhaar, opts)
+}
+
+// PANs finds all Indian Permanent Account Numbers (PAN)
+func PANs(text string, opts ...Option) []string {
+	return match(text, KindPAN, opts)
+}
+
+// CPFs finds all Brazilian individual taxpayer numbers (CPF) with valid check
+// digits
+func CPFs(text string, opts ...Option) []string {
+	return match(text, KindCPF, opts)
+}
+
+// CNPJs finds all Brazilian company registration numbers (CNPJ) with valid
+// check digits
+func CNPJs(text string, opts ...Option) []string {
+	return match(text, KindCNPJ, opts)
+}
+
+// RRNs finds all Korean resident registration numbers with a real date of
+// birth and, for those born before October 2020, a valid check digit
+func RRNs(text string, opts ...Option) []string {
+	return match(text, KindRRN, opts)
+}
+
+// Passports finds all US passport numbers with the word "passport" nearby
+func Passports(text string, opts ...Option) []string {
+	return match(text, KindPassport, opts)
+}
+
+// DriversLicenses finds all US driver's licence numbers, in the format of any
+// state, with a keyword such as "DL#" or "driver's license" nearby. Numbers
+// of digits only also need the postal code of a state whose format they fit
+// nearby, as in "TX DL# 12345678".
+func DriversLicenses(text string, opts ...Option) []string {
+	return match(text, KindDriversLicense, opts)
+}
+
+// EINs finds all US employer identification numbers with a keyword such as
+// "EIN" or "tax ID" nearby
+func EINs(text string, opts ...Option) []string {
+	return match(text, KindEIN, opts)
 }
 
-// SSNs finds all SSN strings
-func SSNs(text string) []string {
-	return match(text, SSNRegex)
+// ITINs finds all US individual taxpayer identification numbers with a
+// keyword such as "ITIN" or "tax ID" nearby
+func ITINs(text string, opts ...Option) []string {
+	return match(text, KindITIN, opts)
 }
 
 // MD5Hexes finds all MD5 hex strings
-func MD5Hexes(text string) []string {
-	return match(text, MD5HexRegex)
+func MD5Hexes(text string, opts ...Option) []string {
+	return match(text, KindMD5Hex, opts)
 }
 
 // SHA1Hexes finds all SHA1 hex strings
-func SHA1Hexes(text string) []string {
-	return match(text, SHA1HexRegex)
+func SHA1Hexes(text string, opts ...Option) []string {
+	return match(text, KindSHA1Hex, opts)
 }
 
 // SHA256Hexes finds all SHA256 hex strings
-func SHA256Hexes(text string) []string {
-	return match(text, SHA256HexRegex)
+func SHA256Hexes(text string, opts ...Option) []string {
+	return match(text, KindSHA256Hex, opts)
 }
 
 // GUIDs finds all GUID strings
-func GUIDs(text string) []string {
-	return match(text, GUIDRegex)
+func GUIDs(text string, opts ...Option) []string {
+	return match(text, KindGUID, opts)
 }
 
 // ISBN13s finds all ISBN13 strings
-func ISBN13s(text string) []string {
-	return match(text, ISBN13Regex)
+func ISBN13s(text string, opts ...Option) []string {
+	return match(text, KindISBN13, opts)
 }
 
 // ISBN10s finds all ISBN10 strings
-func ISBN10s(text string) []string {
-	return match(text, ISBN10Regex)
+func ISBN10s(text string, opts ...Option) []string {
+	return match(text, KindISBN10, opts)
 }
 
 // VISACreditCards finds all VISA credit card numbers
-func VISACreditCards(text string) []string {
-	return match(text, VISACreditCardRegex)
+func VISACreditCards(text string, opts ...Option) []string {
+	return match(text, KindVISACreditCard, opts)
 }
 
 // MCCreditCards finds all MasterCard credit card numbers
-func MCCreditCards(text string) []string {
-	return match(text, MCCreditCardRegex)
+func MCCreditCards(text string, opts ...Option) []string {
+	return match(text, KindMCCreditCard, opts)
 }
 
 // MACAddresses finds all MAC addresses
-func MACAddresses(text string) []string {
-	return match(text, MACAddressRegex)
+func MACAddresses(text string, opts ...Option) []string {
+	return match(text, KindMACAddress, opts)
 }
 
 // IBANs finds all IBAN strings
-func IBANs(text string) []string {
-	return match(text, IBANRegex)
+func IBANs(text string, opts ...Option) []string {
+	return match(text, KindIBAN, opts)
 }
 
 // GitRepos finds all git repository addresses which have protocol prefix
-func GitRepos(text string) []string {
-	return match(text, GitRepoRegex)
+func GitRepos(text string, opts ...Option) []string {
+	return match(text, KindGitRepo, opts)
 }
@@ -1,6 +1,7 @@
 package commonregex
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -18,12 +19,17 @@ func TestCommonRegex_Date(t *testing.T) {
 		"Mar 23th 2017",
 		"Mar. 23th, 2017",
 		"23 Mar 2017",
+		"2017-03-23",
+		"2017/3/23",
 	}
 
 	for _, test := range tests {
 		parsed := Date(test)
 		assert.Equal([]string{test}, parsed, "they should be matched")
 	}
+
+	assert.Equal([]string{"2017-03-23"}, Date("2017-03-23T09:45:00Z"), "a date should be found before an ISO 8601 time")
+	assert.Empty(Date("2017-03-234"), "a date should not be cut from a longer number")
 }
 
 func TestCommonRegex_Time(t *testing.T) {
@@ -36,14 +42,91 @@ func TestCommonRegex_Time(t *testing.T) {
 		"23:45",
 		"9:00am",
 		"9am",
+		"10am",
 		"9:00 A.M.",
 		"9:00 pm",
+		"09:45:00",
+		"09:45:00.250",
+		"09:45:00Z",
+		"09:45Z",
+		"09:45:00+01:00",
+		"09:45:00 -0500",
+		"9:45 PST",
+		"9:45 pm EST",
 	}
 
 	for _, test := range tests {
 		parsed := Time(test)
 		assert.Equal([]string{test}, parsed, "they should be matched")
 	}
+
+	assert.Equal([]string{"9:45", "10:30"}, Time("9:45-10:30"), "a range should not be read as an offset")
+	assert.Equal([]string{"09:45:00 +0000"}, Time("[23/Mar/2017:09:45:00 +0000]"), "a time should not start inside a number")
+}
+
+func TestCommonRegex_Timestamps(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"2017-03-23T09:45:00Z",
+		"2017-03-23T09:45:00.123456789+01:00",
+		"2017-03-23 09:45:00 +0100",
+		"2017-03-23T09:45",
+		"2017-03-23 09:45:00 PST",
+		"Mar 23 09:45:00",
+		"Mar  3 09:45:00",
+		"Mar 3 09:45:00.250",
+		"Thu Mar 23 09:45:00 2017",
+		"Thu Mar 23 09:45:00 UTC 2017",
+		"[23/Mar/2017:09:45:00 +0000]",
+		"[01/Jan/2017:00:00:00 -0700]",
+	}
+
+	failingTests := []string{
+		"2017-03-23",
+		"2017-13-23T09:45:00Z",
+		"Mar 23 09:45",
+		"mar 23 09:45:00",
+		"23/Mar/2017:09:45:00 +0000",
+		"[23/Mar/2017:09:45:00]",
+	}
+
+	for _, test := range tests {
+		assert.Equal([]string{test}, Timestamps(test), "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(Timestamps(test), "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_EpochTimes(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := map[string]string{
+		`{"ts": 1490262300}`:            "1490262300",
+		"created_at=1490262300123":      "1490262300123",
+		"Unix time 1490262300.5":        "1490262300.5",
+		"epoch: 1700000000 seconds":     "1700000000",
+		"timestamp_ms 1490262300123 ok": "1490262300123",
+	}
+
+	failingTests := []string{
+		"1490262300",
+		"call 1490262300 now",
+		"timestamp 2490262300",
+		"timestamp 14902623001",
+	}
+
+	for text, want := range tests {
+		assert.Equal([]string{want}, EpochTimes(text), "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(EpochTimes(test), "%s should not be matched", test)
+	}
 }
 
 func TestCommonRegex_Phones(t *testing.T) {
@@ -236,11 +319,33 @@ func TestCommonRegex_Prices(t *testing.T) {
 		"$1",
 		"$1,000",
 		"$10,000.00",
+		"€1.200,50",
+		"USD 1,200",
+		"1.200,50 EUR",
+		"1.200,50 €",
+		"-£5.99",
+		"(¥1,000)",
+		"₹500",
+		"₩10,000",
+		"$2.5M",
+		"£5k",
+		"R$ 1.234,56",
+		"CHF 12.50",
+		"₹1,00,000",
+		"₹12,34,567.50",
+		"12.50 CHF",
+		"5k USD",
+		"10 EUR",
 	}
 
 	failingTests := []string{
 		"$1,10,0",
 		"$100.000",
+		"100 EURO",
+		"5kg $",
+		"EUR",
+		"1234",
+		"₹1,00,00",
 	}
 
 	for _, test := range tests {
@@ -250,7 +355,7 @@ func TestCommonRegex_Prices(t *testing.T) {
 
 	for _, test := range failingTests {
 		parsed := Prices(test)
-		assert.NotEqual([]string{test}, parsed, "they should not be matched")
+		assert.Empty(parsed, "%s should not be matched", test)
 	}
 }
 
@@ -325,6 +430,227 @@ func TestCommonRegex_BtcAddresses(t *testing.T) {
 	}
 }
 
+func TestCommonRegex_EthAddresses(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359",
+		"0xDBF03B407C01E7CD3CBEA99509D93F8DDDC8C6FB",
+	}
+
+	failingTests := []string{
+		"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAedd",
+		"0xgaAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	}
+
+	for _, test := range tests {
+		parsed := EthAddresses(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(EthAddresses(test), "they should not be matched")
+	}
+}
+
+func TestCommonRegex_LtcAddresses(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"Lc3veyPCuFHmqvF7VAohVp6VT4zibe1Uhz",
+		"MCgEvMpSTeVTxbwkY6ezHvGY3Tu3modt59",
+		"ltc1qnf92vle8jkamvuvdwpnnrrt4ndykdan8v7yq4y",
+		"ltc1q4mghf07v7s7ttatpe5qyp6zkvgynshrxq8wm8lq5w2ugrkvuss5qjcyxeh",
+	}
+
+	failingTests := []string{
+		"Kc3veyPCuFHmqvF7VAohVp6VT4zibe1Uhz",
+		"Lc3veyPCuFHmqvF7VAohVp6VT4zibe1Uh0",
+		"ltc1qnf92vle8jkamvuvdwpnnrrt4ndykdan8v7yq4b",
+		"ltc1qnf92vle8jkam",
+		"Lc3veyPCuFHmqvF7VAohVp6VT4zibe1Uhy",
+		"ltc1qnf92vle8jkamvuvdwpnnrrt4ndykdan8v7yq4z",
+	}
+
+	for _, test := range tests {
+		parsed := LtcAddresses(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(LtcAddresses(test), "they should not be matched")
+	}
+}
+
+func TestCommonRegex_BchAddresses(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		"qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		"ppvptpappyxx3m78hq4smcl3pd0tttdfqccrlhgy8z",
+	}
+
+	failingTests := []string{
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6",
+		"qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdxba",
+		"zpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6b",
+	}
+
+	for _, test := range tests {
+		parsed := BchAddresses(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(BchAddresses(test), "they should not be matched")
+	}
+}
+
+func TestCommonRegex_DogeAddresses(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"D5oULHCPTEVFPSje66rfSoXz1fMYLhnFiB",
+		"DKgUPR1foeiU6qLXHT4kMLjVUFrkR6XDjo",
+	}
+
+	failingTests := []string{
+		"D1oULHCPTEVFPSje66rfSoXz1fMYLhnFiB",
+		"DKgUPR1foeiU6qLXHT4kMLjVUFrkR6XDj",
+		"DKgUPR1foeiU6qLXHT4kMLjVUFrkR6XDjoo",
+		"D5oULHCPTEVFPSje66rfSoXz1fMYLhnFiC",
+	}
+
+	for _, test := range tests {
+		parsed := DogeAddresses(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(DogeAddresses(test), "they should not be matched")
+	}
+}
+
+func TestCommonRegex_XmrAddresses(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A",
+		"86eHGeoFxRQRs1ZCNgsqLCMLtAPbUG4Ex9CGnoLUYjZqb9ttwLm2PZF3mUsu59VYDkKeUFGr8M1KxDJQt4HnWWn7TzxfUQ3",
+		"4DkgKMUyFHJF4h8RKdkN6sDngUeWv1PSGQXuAUoakrK6Tc59odwg2J74x1fvBz15hzinz13rsSZ6FAhEn1RgvdY9C47nuWwk4WwLBQMrfQ",
+	}
+
+	failingTests := []string{
+		"44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3",
+		"54AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A",
+		"44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3AA",
+		"44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3B",
+	}
+
+	for _, test := range tests {
+		parsed := XmrAddresses(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(XmrAddresses(test), "they should not be matched")
+	}
+}
+
+func TestCommonRegex_SolAddresses(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"So11111111111111111111111111111111111111112",
+		"11111111111111111111111111111111",
+		"7tUAv95W9QwyzY6NmdfnJoqfEi1YHBoNCh5qffrKorpm",
+	}
+
+	failingTests := []string{
+		"So1111111111111111111111111111111111111111211",
+		"1111111111111111111111111111111",
+		"So11111111111111111111111111111111111111110",
+	}
+
+	for _, test := range tests {
+		parsed := SolAddresses("Solana wallet: " + test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+		assert.Empty(SolAddresses(test), "they should not be matched without a keyword")
+	}
+
+	for _, test := range failingTests {
+		parsed := SolAddresses("Solana wallet: " + test)
+		assert.NotEqual([]string{test}, parsed, "they should not be matched")
+	}
+}
+
+func TestCommonRegex_TrxAddresses(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+		"TGzZL2nY4Xayk4BNDAhuVcV81Bk4X2veGL",
+	}
+
+	failingTests := []string{
+		"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6",
+		"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6tt",
+		"RR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+		"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u",
+		"TTTTTTTTTTTTTTTTTTTTTTTTTTTTTTTTTT",
+	}
+
+	for _, test := range tests {
+		parsed := TrxAddresses(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(TrxAddresses(test), "they should not be matched")
+	}
+}
+
+func TestCommonRegex_XrpAddresses(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"rrrrrrrrrrrrrrrrrrrrBZbvji",
+		"rB6QzwzGCL8Wf82NWnoPUcpieQ9AFsFw2u",
+	}
+
+	failingTests := []string{
+		"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh0",
+		"rHb9CJAWy",
+		"xHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTj",
+		"rorrrrrrrrrrrrrrrrrrrrrrrrrr",
+	}
+
+	for _, test := range tests {
+		parsed := XrpAddresses(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(XrpAddresses(test), "they should not be matched")
+	}
+}
+
 func TestCommonRegex_StreetAddresses(t *testing.T) {
 	t.Parallel()
 	assert := assert.New(t)
@@ -334,10 +660,13 @@ func TestCommonRegex_StreetAddresses(t *testing.T) {
 		"504 parkwood drive",
 		"3 elm boulevard",
 		"500 elm street ",
+		"12 Riverside Parkway",
 	}
 
 	failingTests := []string{
 		"101 main straight",
+		"Buy 2 display cases",
+		"3 people will stay",
 	}
 
 	for _, test := range tests {
@@ -347,7 +676,7 @@ func TestCommonRegex_StreetAddresses(t *testing.T) {
 
 	for _, test := range failingTests {
 		parsed := StreetAddresses(test)
-		assert.NotEqual([]string{test}, parsed, "they should not be matched")
+		assert.Empty(parsed, "%s should not be matched", test)
 	}
 }
 
@@ -405,16 +734,513 @@ func TestCommonRegex_SSNs(t *testing.T) {
 	assert := assert.New(t)
 
 	tests := []string{
-		"000-00-0000",
 		"111-11-1111",
 		"222-22-2222",
 		"123-45-6789",
+		"899-99-9999",
+	}
+
+	failingTests := []string{
+		"000-00-0000",
+		"000-12-3456",
+		"666-12-3456",
+		"912-34-5678",
+		"123-00-4567",
+		"123-45-0000",
+		"078-05-1120",
 	}
 
 	for _, test := range tests {
 		parsed := SSNs(test)
 		assert.Equal([]string{test}, parsed, "they should be matched")
 	}
+
+	for _, test := range failingTests {
+		parsed := SSNs(test)
+		assert.Empty(parsed, "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_NINOs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"AB123456C",
+		"AB 12 34 56 C",
+		"JG 10 37 45 D",
+	}
+
+	failingTests := []string{
+		"DA123456C",
+		"AB123456E",
+		"AB12345C",
+		"AB1234567C",
+		"BG123456C",
+	}
+
+	for _, test := range tests {
+		parsed := NINOs(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		parsed := NINOs(test)
+		assert.Empty(parsed, "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_SINs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"130 692 544",
+		"130-692-544",
+		"130692544",
+	}
+
+	failingTests := []string{
+		"030 692 544",
+		"830 692 544",
+		"130 692 5444",
+		"13 0692 544",
+		"123 456 789",
+		"130 692 545",
+	}
+
+	for _, test := range tests {
+		parsed := SINs(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		parsed := SINs(test)
+		assert.Empty(parsed, "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_NIRs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"1 84 12 76 451 089 46",
+		"184127645108946",
+		"2 69 05 2A 588 157 17",
+	}
+
+	failingTests := []string{
+		"5 84 12 76 451 089 46",
+		"1 84 00 76 451 089 46",
+		"18412764510894",
+		"1 84 12 76 451 089 47",
+	}
+
+	for _, test := range tests {
+		parsed := NIRs(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		parsed := NIRs(test)
+		assert.Empty(parsed, "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_SteuerIDs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"86095742719",
+		"86 095 742 719",
+	}
+
+	failingTests := []string{
+		"06095742719",
+		"8609574271",
+		"860957427190",
+		"12345678901",
+		"86095742718",
+	}
+
+	for _, test := range tests {
+		parsed := SteuerIDs(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		parsed := SteuerIDs(test)
+		assert.Empty(parsed, "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_FiscalCodes(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"RSSMRA85T10A562S",
+		"RSSMRA85T10A56NS",
+	}
+
+	failingTests := []string{
+		"RSSMRA85Z10A562S",
+		"RSSMRA85T10A562",
+		"RSSMR485T10A562S",
+		"RSSMRA85T10A562T",
+	}
+
+	for _, test := range tests {
+		parsed := FiscalCodes(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		parsed := FiscalCodes(test)
+		assert.Empty(parsed, "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_DNIs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"12345678Z",
+		"12345678-Z",
+	}
+
+	failingTests := []string{
+		"1234567Z",
+		"12345678U",
+		"123456789Z",
+		"12345678A",
+	}
+
+	for _, test := range tests {
+		parsed := DNIs(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		parsed := DNIs(test)
+		assert.Empty(parsed, "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_NIEs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"X1234567L",
+		"Y-1234567-X",
+		"Z1234567R",
+	}
+
+	failingTests := []string{
+		"A1234567L",
+		"X123456L",
+		"X12345678L",
+		"X1234567A",
+	}
+
+	for _, test := range tests {
+		parsed := NIEs(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		parsed := NIEs(test)
+		assert.Empty(parsed, "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_BSNs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"111222333",
+		"1112.22.333",
+	}
+
+	failingTests := []string{
+		"11122233",
+		"1112223334",
+		"11.122.2333",
+		"123456789",
+		"111222334",
+	}
+
+	for _, test := range tests {
+		parsed := BSNs(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		parsed := BSNs(test)
+		assert.Empty(parsed, "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_Aadhaars(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"2341 2341 2346",
+		"234123412346",
+		"2341-2341-2346",
+	}
+
+	failingTests := []string{
+		"1341 2341 2346",
+		"2341 2341 234",
+		"2341 2341 23466",
+		"2345 6789 0123",
+		"2341 2341 2347",
+	}
+
+	for _, test := range tests {
+		parsed := Aadhaars(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		parsed := Aadhaars(test)
+		assert.Empty(parsed, "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_PANs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"ABCPE1234F",
+		"AAAAA9999Z",
+	}
+
+	failingTests := []string{
+		"ABCDE1234F",
+		"ABCP1234F",
+		"ABCPE12345",
+	}
+
+	for _, test := range tests {
+		parsed := PANs(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		parsed := PANs(test)
+		assert.NotEqual([]string{test}, parsed, "they should not be matched")
+	}
+}
+
+func TestCommonRegex_CPFs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"529.982.247-25",
+		"52998224725",
+	}
+
+	failingTests := []string{
+		"529.982.247-2",
+		"5299822472",
+		"529.982.247-255",
+		"12345678901",
+		"529.982.247-26",
+	}
+
+	for _, test := range tests {
+		parsed := CPFs(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		parsed := CPFs(test)
+		assert.Empty(parsed, "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_CNPJs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"11.222.333/0001-81",
+		"11222333000181",
+	}
+
+	failingTests := []string{
+		"11.222.333/0001-8",
+		"1122233300018",
+		"11.222.333/00011-81",
+		"11.222.333/0001-82",
+	}
+
+	for _, test := range tests {
+		parsed := CNPJs(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		parsed := CNPJs(test)
+		assert.Empty(parsed, "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_RRNs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"900101-1234568",
+		"9001011234568",
+	}
+
+	failingTests := []string{
+		"901301-1234568",
+		"900132-1234568",
+		"900101-9234568",
+		"900101-123456",
+		"900101-1234567",
+		"900230-1234568",
+	}
+
+	for _, test := range tests {
+		parsed := RRNs(test)
+		assert.Equal([]string{test}, parsed, "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		parsed := RRNs(test)
+		assert.Empty(parsed, "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_Passports(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := map[string]string{
+		"Passport No: C03005988":             "C03005988",
+		"passport number 340020013":          "340020013",
+		"Her passports are 123456789.":       "123456789",
+		"A1234567 renewed, passport expired": "",
+	}
+
+	failingTests := []string{
+		"C03005988",
+		"order 340020013 shipped",
+		"passport 12345678",
+		"passport C0300598",
+		"passportC03005988",
+		"passport " + strings.Repeat("x", 60) + " 340020013",
+	}
+
+	for text, want := range tests {
+		if want == "" {
+			assert.Empty(Passports(text), "%s should not be matched", text)
+			continue
+		}
+		assert.Equal([]string{want}, Passports(text), "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(Passports(test), "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_DriversLicenses(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := map[string]string{
+		"Driver's License: D1234567":           "D1234567",
+		"drivers license number F123456789012": "F123456789012",
+		"DL# 12ABC34567":                       "12ABC34567",
+		"driving licence WDLABCD1234E":         "WDLABCD1234E",
+		"NY License #: 123456789":              "123456789",
+		"D/L 1234567 (class C, TX)":            "1234567",
+		"Missouri DL# 123456789AB":             "123456789AB",
+	}
+
+	failingTests := []string{
+		"D1234567",
+		"invoice D1234567",
+		"driver's license 123",
+		"licensed since D1234567",
+		"Driver's license renewed in 2017, see PASSPORT OFFICE",
+		"License #: 123456789",
+		"PA driver's license 123456789",
+	}
+
+	for text, want := range tests {
+		assert.Equal([]string{want}, DriversLicenses(text), "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(DriversLicenses(test), "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_EINs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := map[string]string{
+		"EIN: 12-3456789": "12-3456789",
+		"Employer Identification Number 98-7654321": "98-7654321",
+		"our tax ID is 45-1234567.":                 "45-1234567",
+	}
+
+	failingTests := []string{
+		"12-3456789",
+		"EIN 123456789",
+		"EIN 12-345678",
+		"call 12-3456789 for the tin ein",
+	}
+
+	for text, want := range tests {
+		assert.Equal([]string{want}, EINs(text), "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(EINs(test), "%s should not be matched", test)
+	}
+}
+
+func TestCommonRegex_ITINs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := map[string]string{
+		"ITIN: 912-70-1234":                 "912-70-1234",
+		"taxpayer identification 900941234": "900941234",
+		"TIN 999-88-1234":                   "999-88-1234",
+	}
+
+	failingTests := []string{
+		"912-70-1234",
+		"ITIN 912-93-1234",
+		"ITIN 912-66-1234",
+		"ITIN 812-70-1234",
+	}
+
+	for text, want := range tests {
+		assert.Equal([]string{want}, ITINs(text), "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(ITINs(test), "%s should not be matched", test)
+	}
 }
 
 func TestCommonRegex_MD5Hexes(t *testing.T) {
@@ -0,0 +1,193 @@
+package commonregex
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	// concurrentChunkSize is the size of the chunks ScanConcurrent splits
+	// its input into
+	concurrentChunkSize = 256 << 10
+	// concurrentOverlap is how far each chunk is scanned past its end so that
+	// matches crossing into the next chunk are found whole. Matches longer
+	// than this may be cut short.
+	concurrentOverlap = 4 << 10
+	// boundarySearch is how far back a chunk boundary is moved to fall on a
+	// line break, whitespace or punctuation. A longer run of word characters
+	// is cut, and patterns matching a word boundary there may then find
+	// other matches than Scan.
+	boundarySearch = 1 << 10
+)
+
+// chunk is a part of the text a worker scans. Matches are kept if they start
+// in [start, end) and are searched for in [start, limit).
+type chunk struct {
+	start, end, limit int
+}
+
+// ScanConcurrent finds the matches of the given kinds, or of every built-in
+// kind if none are given, like Scan. Large texts are split into overlapping
+// chunks at line breaks, whitespace or punctuation which a pool of GOMAXPROCS
+// goroutines scans in parallel. The matches are merged in offset order. Where
+// a match runs past the end of its chunk, the next chunk is searched again
+// from the end of the match, as Scan does. The workers check ctx before each
+// pattern runs on a chunk, and if ctx is done before the scan finishes,
+// ScanConcurrent returns ctx.Err(). Use ScanContext to bound the work done on
+// untrusted input.
+func ScanConcurrent(ctx context.Context, text string, kinds ...Kind) ([]Match, error) {
+	return scanConcurrent(ctx, text, concurrentChunkSize, concurrentOverlap, runtime.GOMAXPROCS(0), kinds)
+}
+
+func scanConcurrent(ctx context.Context, text string, chunkSize, overlap, workers int, kinds []Kind) ([]Match, error) {
+	chunks := splitChunks(text, chunkSize, overlap)
+	results := make([][]Match, len(chunks))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	queue := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(chunks); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				results[i] = scanChunk(ctx, text, chunks[i], kinds)
+			}
+		}()
+	}
+
+	var err error
+feed:
+	for i := range chunks {
+		select {
+		case queue <- i:
+		case <-ctx.Done():
+			err = ctx.Err()
+			break feed
+		}
+	}
+	close(queue)
+	wg.Wait()
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mergeChunks(text, chunks, results, kinds), nil
+}
+
+// splitChunks cuts text into chunks of about chunkSize bytes
+func splitChunks(text string, chunkSize, overlap int) []chunk {
+	var chunks []chunk
+	for start := 0; start < len(text); {
+		end := len(text)
+		if start+chunkSize < len(text) {
+			end = safeBoundary(text, start+chunkSize, start+1)
+		}
+		limit := len(text)
+		if end+overlap < len(text) {
+			limit = safeBoundary(text, end+overlap, end)
+		}
+		chunks = append(chunks, chunk{start: start, end: end, limit: limit})
+		start = end
+	}
+	return chunks
+}
+
+// safeBoundary moves pos back to just after the closest line break, or else
+// the closest whitespace or ASCII punctuation, but not before min. A chunk
+// starting there sees the same word boundaries as the whole text. Failing
+// all three, pos is only moved to the start of a UTF-8 sequence.
+func safeBoundary(text string, pos, min int) int {
+	from := pos - boundarySearch
+	if from < min {
+		from = min
+	}
+	if i := strings.LastIndexByte(text[from:pos], '\n'); i >= 0 {
+		return from + i + 1
+	}
+	if i := strings.LastIndexAny(text[from:pos], " \t\r\f\v"); i >= 0 {
+		return from + i + 1
+	}
+	for i := pos; i > from; i-- {
+		if b := text[i-1]; b < utf8.RuneSelf && !isWordByte(b) {
+			return i
+		}
+	}
+	for pos > min && !utf8.RuneStart(text[pos]) {
+		pos--
+	}
+	return pos
+}
+
+// scanChunk scans a chunk for each kind in turn, giving up once ctx is done
+func scanChunk(ctx context.Context, text string, c chunk, kinds []Kind) []Match {
+	var matches []Match
+	for _, info := range selectKinds(kinds) {
+		if ctx.Err() != nil {
+			return nil
+		}
+		for _, match := range findMatches(text, c.start, c.limit, info) {
+			if match.Start >= c.end {
+				break
+			}
+			matches = append(matches, match)
+		}
+	}
+	sortMatches(matches)
+	return matches
+}
+
+// mergeChunks joins the matches of consecutive chunks. A chunk cut inside a
+// match of a kind was scanned for that kind from the wrong place: Scan
+// resumes after the match, while the chunk may find fragments of it and go on
+// from there. Such kinds are scanned again from the end of the match.
+func mergeChunks(text string, chunks []chunk, results [][]Match, kinds []Kind) []Match {
+	var merged []Match
+	ends := make(map[Kind]int)
+	for i, matches := range results {
+		c := chunks[i]
+		var rescanned []Match
+		cut := false
+		for _, info := range selectKinds(kinds) {
+			if ends[info.kind] > c.start {
+				rescanned = append(rescanned, rescanChunk(text, c, ends[info.kind], info)...)
+				cut = true
+			}
+		}
+		if cut {
+			for _, match := range matches {
+				if ends[match.Kind] <= c.start {
+					rescanned = append(rescanned, match)
+				}
+			}
+			sortMatches(rescanned)
+			matches = rescanned
+		}
+		for _, match := range matches {
+			merged = append(merged, match)
+			ends[match.Kind] = match.End
+		}
+	}
+	return merged
+}
+
+// rescanChunk finds the matches of info in c from offset from on
+func rescanChunk(text string, c chunk, from int, info kindInfo) []Match {
+	if from >= c.end {
+		return nil
+	}
+	var matches []Match
+	for _, match := range findMatches(text, from, c.limit, info) {
+		if match.Start >= c.end {
+			break
+		}
+		matches = append(matches, match)
+	}
+	return matches
+}
@@ -0,0 +1,107 @@
+package commonregex
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrent_ScanConcurrent(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	for _, name := range corpora {
+		data, err := os.ReadFile(filepath.Join("testdata", "corpus", name))
+		assert.NoError(err)
+		text := strings.Repeat(string(data), 3)
+
+		matches, err := scanConcurrent(context.Background(), text, 512, 256, 4, nil)
+		assert.NoError(err)
+		assert.Equal(Scan(text), matches, "%s should scan the same concurrently", name)
+
+		matches, err = ScanConcurrent(context.Background(), text, KindEmail, KindIPv4)
+		assert.NoError(err)
+		assert.Equal(Scan(text, KindEmail, KindIPv4), matches)
+	}
+
+	matches, err := ScanConcurrent(context.Background(), "")
+	assert.NoError(err)
+	assert.Empty(matches)
+}
+
+func TestConcurrent_Overlap(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	// With no whitespace to move back to, the first boundary falls after the
+	// dot inside the email address. The first chunk sees it whole; the second
+	// finds its tail and must drop it.
+	text := strings.Repeat("x,", 45) + "john.smith@example.com" + strings.Repeat(",y", 50)
+	assert.Equal([]chunk{{0, 95, 145}, {95, 195, 212}, {195, 212, 212}}, splitChunks(text, 100, 50))
+	assert.Equal([]Match{{Kind: KindEmail, Value: "smith@example.com", Start: 95, End: 112}},
+		scanChunk(context.Background(), text, chunk{95, 195, 212}, []Kind{KindEmail}))
+
+	matches, err := scanConcurrent(context.Background(), text, 100, 50, 2, []Kind{KindEmail})
+	assert.NoError(err)
+	assert.Equal([]Match{{Kind: KindEmail, Value: "john.smith@example.com", Start: 90, End: 112}}, matches)
+}
+
+func TestConcurrent_SmallChunks(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	for _, name := range corpora {
+		data, err := os.ReadFile(filepath.Join("testdata", "corpus", name))
+		assert.NoError(err)
+		text := strings.Repeat(string(data), 3)
+
+		for _, size := range []int{64, 100, 200} {
+			matches, err := scanConcurrent(context.Background(), text, size, 256, 4, nil)
+			assert.NoError(err)
+			assert.Equal(Scan(text), matches, "%s should scan the same in chunks of %d", name, size)
+		}
+	}
+
+	// The run of hex digits has nowhere to cut but inside the color crossing
+	// the boundary at 100. Resuming after it, the second chunk must find the
+	// colors Scan finds rather than ones shifted by the cut.
+	text := strings.Repeat("a1b2c3", 40)
+	assert.Equal([]chunk{{0, 100, 150}, {100, 200, 240}, {200, 240, 240}}, splitChunks(text, 100, 50))
+	matches, err := scanConcurrent(context.Background(), text, 100, 50, 2, []Kind{KindHexColor})
+	assert.NoError(err)
+	assert.Equal(Scan(text, KindHexColor), matches)
+}
+
+func TestConcurrent_Canceled(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	matches, err := ScanConcurrent(ctx, "john.smith@example.com")
+	assert.Equal(context.Canceled, err)
+	assert.Nil(matches)
+}
+
+func TestConcurrent_SplitChunks(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := "first line\nsecond line\nthird line\n"
+	chunks := splitChunks(text, 15, 5)
+	assert.Equal([]chunk{
+		{start: 0, end: 11, limit: 16},
+		{start: 11, end: 23, limit: 28},
+		{start: 23, end: 34, limit: 34},
+	}, chunks)
+
+	chunks = splitChunks(strings.Repeat("é", 10), 5, 2)
+	for _, c := range chunks {
+		assert.True(c.start%2 == 0 && c.end%2 == 0, "chunks should not split runes")
+	}
+	assert.Nil(splitChunks("", 10, 5))
+}
@@ -0,0 +1,304 @@
+package commonregex
+
+// Contains reports whether text contains a match of kind. It stops at the
+// first match.
+func Contains(text string, kind Kind) bool {
+	info, ok := lookupKind(kind)
+	return ok && info.findAllIndex(text, 1) != nil
+}
+
+// HasAny reports whether text contains a match of any of the given kinds, or
+// of any built-in kind if none are given
+func HasAny(text string, kinds ...Kind) bool {
+	for _, info := range selectKinds(kinds) {
+		if info.findAllIndex(text, 1) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsDate reports whether text contains a date
+func ContainsDate(text string) bool {
+	return Contains(text, KindDate)
+}
+
+// ContainsTime reports whether text contains a time
+func ContainsTime(text string) bool {
+	return Contains(text, KindTime)
+}
+
+// ContainsTimestamp reports whether text contains a log timestamp
+func ContainsTimestamp(text string) bool {
+	return Contains(text, KindTimestamp)
+}
+
+// ContainsEpochTime reports whether text contains a Unix time near a keyword
+func ContainsEpochTime(text string) bool {
+	return Contains(text, KindEpochTime)
+}
+
+// ContainsPhone reports whether text contains a phone number
+func ContainsPhone(text string) bool {
+	return Contains(text, KindPhone)
+}
+
+// ContainsPhoneWithExt reports whether text contains a phone number with ext
+func ContainsPhoneWithExt(text string) bool {
+	return Contains(text, KindPhoneWithExt)
+}
+
+// ContainsLink reports whether text contains a link
+func ContainsLink(text string) bool {
+	return Contains(text, KindLink)
+}
+
+// ContainsEmail reports whether text contains an email
+func ContainsEmail(text string) bool {
+	return Contains(text, KindEmail)
+}
+
+// ContainsIPv4 reports whether text contains an IPv4 address
+func ContainsIPv4(text string) bool {
+	return Contains(text, KindIPv4)
+}
+
+// ContainsIPv6 reports whether text contains an IPv6 address
+func ContainsIPv6(text string) bool {
+	return Contains(text, KindIPv6)
+}
+
+// ContainsIP reports whether text contains an IP address (either IPv4 or IPv6)
+func ContainsIP(text string) bool {
+	return Contains(text, KindIP)
+}
+
+// ContainsNotKnownPort reports whether text contains a not-known port number
+func ContainsNotKnownPort(text string) bool {
+	return Contains(text, KindNotKnownPort)
+}
+
+// ContainsPrice reports whether text contains a price
+func ContainsPrice(text string) bool {
+	return Contains(text, KindPrice)
+}
+
+// ContainsHexColor reports whether text contains a hex color value
+func ContainsHexColor(text string) bool {
+	return Contains(text, KindHexColor)
+}
+
+// ContainsCreditCard reports whether text contains a credit card number
+func ContainsCreditCard(text string) bool {
+	return Contains(text, KindCreditCard)
+}
+
+// ContainsBtcAddress reports whether text contains a bitcoin address
+func ContainsBtcAddress(text string) bool {
+	return Contains(text, KindBtcAddress)
+}
+
+// ContainsEthAddress reports whether text contains an Ethereum address
+func ContainsEthAddress(text string) bool {
+	return Contains(text, KindEthAddress)
+}
+
+// ContainsLtcAddress reports whether text contains a Litecoin address
+func ContainsLtcAddress(text string) bool {
+	return Contains(text, KindLtcAddress)
+}
+
+// ContainsBchAddress reports whether text contains a Bitcoin Cash CashAddr address
+func ContainsBchAddress(text string) bool {
+	return Contains(text, KindBchAddress)
+}
+
+// ContainsDogeAddress reports whether text contains a Dogecoin address
+func ContainsDogeAddress(text string) bool {
+	return Contains(text, KindDogeAddress)
+}
+
+// ContainsXmrAddress reports whether text contains a Monero address
+func ContainsXmrAddress(text string) bool {
+	return Contains(text, KindXmrAddress)
+}
+
+// ContainsSolAddress reports whether text contains a Solana address near a
+// keyword
+func ContainsSolAddress(text string) bool {
+	return Contains(text, KindSolAddress)
+}
+
+// ContainsTrxAddress reports whether text contains a Tron address
+func ContainsTrxAddress(text string) bool {
+	return Contains(text, KindTrxAddress)
+}
+
+// ContainsXrpAddress reports whether text contains an XRP Ledger address
+func ContainsXrpAddress(text string) bool {
+	return Contains(text, KindXrpAddress)
+}
+
+// ContainsStreetAddress reports whether text contains a street address
+func ContainsStreetAddress(text string) bool {
+	return Contains(text, KindStreetAddress)
+}
+
+// ContainsZipCode reports whether text contains a zip code
+func ContainsZipCode(text string) bool {
+	return Contains(text, KindZipCode)
+}
+
+// ContainsPoBox reports whether text contains a po-box
+func ContainsPoBox(text string) bool {
+	return Contains(text, KindPoBox)
+}
+
+// ContainsSSN reports whether text contains an SSN
+func ContainsSSN(text string) bool {
+	return Contains(text, KindSSN)
+}
+
+// ContainsNINO reports whether text contains a UK National Insurance number
+func ContainsNINO(text string) bool {
+	return Contains(text, KindNINO)
+}
+
+// ContainsSIN reports whether text contains a Canadian Social Insurance Number
+func ContainsSIN(text string) bool {
+	return Contains(text, KindSIN)
+}
+
+// ContainsNIR reports whether text contains a French social security number
+func ContainsNIR(text string) bool {
+	return Contains(text, KindNIR)
+}
+
+// ContainsSteuerID reports whether text contains a German tax identification number
+func ContainsSteuerID(text string) bool {
+	return Contains(text, KindSteuerID)
+}
+
+// ContainsFiscalCode reports whether text contains an Italian fiscal code
+func ContainsFiscalCode(text string) bool {
+	return Contains(text, KindFiscalCode)
+}
+
+// ContainsDNI reports whether text contains a Spanish national identity number
+func ContainsDNI(text string) bool {
+	return Contains(text, KindDNI)
+}
+
+// ContainsNIE reports whether text contains a Spanish foreigner identity number
+func ContainsNIE(text string) bool {
+	return Contains(text, KindNIE)
+}
+
+// ContainsBSN reports whether text contains a Dutch citizen service number
+func ContainsBSN(text string) bool {
+	return Contains(text, KindBSN)
+}
+
+// ContainsAadhaar reports whether text contains an Indian Aadhaar number
+func ContainsAadhaar(text string) bool {
+	return Contains(text, KindAadhaar)
+}
+
+// ContainsPAN reports whether text contains an Indian Permanent Account Number
+func ContainsPAN(text string) bool {
+	return Contains(text, KindPAN)
+}
+
+// ContainsCPF reports whether text contains a Brazilian individual taxpayer number
+func ContainsCPF(text string) bool {
+	return Contains(text, KindCPF)
+}
+
+// ContainsCNPJ reports whether text contains a Brazilian company registration number
+func ContainsCNPJ(text string) bool {
+	return Contains(text, KindCNPJ)
+}
+
+// ContainsRRN reports whether text contains a Korean resident registration number
+func ContainsRRN(text string) bool {
+	return Contains(text, KindRRN)
+}
+
+// ContainsPassport reports whether text contains a US passport number near
+// the word "passport"
+func ContainsPassport(text string) bool {
+	return Contains(text, KindPassport)
+}
+
+// ContainsDriversLicense reports whether text contains a US driver's licence
+// number near a keyword
+func ContainsDriversLicense(text string) bool {
+	return Contains(text, KindDriversLicense)
+}
+
+// ContainsEIN reports whether text contains a US employer identification
+// number near a keyword
+func ContainsEIN(text string) bool {
+	return Contains(text, KindEIN)
+}
+
+// ContainsITIN reports whether text contains a US individual taxpayer
+// identification number near a keyword
+func ContainsITIN(text string) bool {
+	return Contains(text, KindITIN)
+}
+
+// ContainsMD5Hex reports whether text contains an MD5 hex string
+func ContainsMD5Hex(text string) bool {
+	return Contains(text, KindMD5Hex)
+}
+
+// ContainsSHA1Hex reports whether text contains a SHA1 hex string
+func ContainsSHA1Hex(text string) bool {
+	return Contains(text, KindSHA1Hex)
+}
+
+// ContainsSHA256Hex reports whether text contains a SHA256 hex string
+func ContainsSHA256Hex(text string) bool {
+	return Contains(text, KindSHA256Hex)
+}
+
+// ContainsGUID reports whether text contains a GUID
+func ContainsGUID(text string) bool {
+	return Contains(text, KindGUID)
+}
+
+// ContainsISBN13 reports whether text contains an ISBN13
+func ContainsISBN13(text string) bool {
+	return Contains(text, KindISBN13)
+}
+
+// ContainsISBN10 reports whether text contains an ISBN10
+func ContainsISBN10(text string) bool {
+	return Contains(text, KindISBN10)
+}
+
+// ContainsVISACreditCard reports whether text contains a VISA credit card number
+func ContainsVISACreditCard(text string) bool {
+	return Contains(text, KindVISACreditCard)
+}
+
+// ContainsMCCreditCard reports whether text contains a MasterCard credit card number
+func ContainsMCCreditCard(text string) bool {
+	return Contains(text, KindMCCreditCard)
+}
+
+// ContainsMACAddress reports whether text contains a MAC address
+func ContainsMACAddress(text string) bool {
+	return Contains(text, KindMACAddress)
+}
+
+// ContainsIBAN reports whether text contains an IBAN
+func ContainsIBAN(text string) bool {
+	return Contains(text, KindIBAN)
+}
+
+// ContainsGitRepo reports whether text contains a git repository address
+func ContainsGitRepo(text string) bool {
+	return Contains(text, KindGitRepo)
+}
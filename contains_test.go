@@ -0,0 +1,95 @@
+package commonregex
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContains_Kinds(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	contains := map[Kind]func(string) bool{
+		KindDate:           ContainsDate,
+		KindTime:           ContainsTime,
+		KindTimestamp:      ContainsTimestamp,
+		KindEpochTime:      ContainsEpochTime,
+		KindPhone:          ContainsPhone,
+		KindPhoneWithExt:   ContainsPhoneWithExt,
+		KindLink:           ContainsLink,
+		KindEmail:          ContainsEmail,
+		KindIPv4:           ContainsIPv4,
+		KindIPv6:           ContainsIPv6,
+		KindIP:             ContainsIP,
+		KindNotKnownPort:   ContainsNotKnownPort,
+		KindPrice:          ContainsPrice,
+		KindHexColor:       ContainsHexColor,
+		KindCreditCard:     ContainsCreditCard,
+		KindBtcAddress:     ContainsBtcAddress,
+		KindEthAddress:     ContainsEthAddress,
+		KindLtcAddress:     ContainsLtcAddress,
+		KindBchAddress:     ContainsBchAddress,
+		KindDogeAddress:    ContainsDogeAddress,
+		KindXmrAddress:     ContainsXmrAddress,
+		KindSolAddress:     ContainsSolAddress,
+		KindTrxAddress:     ContainsTrxAddress,
+		KindXrpAddress:     ContainsXrpAddress,
+		KindStreetAddress:  ContainsStreetAddress,
+		KindZipCode:        ContainsZipCode,
+		KindPoBox:          ContainsPoBox,
+		KindSSN:            ContainsSSN,
+		KindNINO:           ContainsNINO,
+		KindSIN:            ContainsSIN,
+		KindNIR:            ContainsNIR,
+		KindSteuerID:       ContainsSteuerID,
+		KindFiscalCode:     ContainsFiscalCode,
+		KindDNI:            ContainsDNI,
+		KindNIE:            ContainsNIE,
+		KindBSN:            ContainsBSN,
+		KindAadhaar:        ContainsAadhaar,
+		KindPAN:            ContainsPAN,
+		KindCPF:            ContainsCPF,
+		KindCNPJ:           ContainsCNPJ,
+		KindRRN:            ContainsRRN,
+		KindPassport:       ContainsPassport,
+		KindDriversLicense: ContainsDriversLicense,
+		KindEIN:            ContainsEIN,
+		KindITIN:           ContainsITIN,
+		KindMD5Hex:         ContainsMD5Hex,
+		KindSHA1Hex:        ContainsSHA1Hex,
+		KindSHA256Hex:      ContainsSHA256Hex,
+		KindGUID:           ContainsGUID,
+		KindISBN13:         ContainsISBN13,
+		KindISBN10:         ContainsISBN10,
+		KindVISACreditCard: ContainsVISACreditCard,
+		KindMCCreditCard:   ContainsMCCreditCard,
+		KindMACAddress:     ContainsMACAddress,
+		KindIBAN:           ContainsIBAN,
+		KindGitRepo:        ContainsGitRepo,
+	}
+	assert.Len(contains, len(builtinKinds))
+
+	r := rand.New(rand.NewSource(1))
+	for i, f := range finders {
+		kind := builtinKinds[i].kind
+		value := f.generate(r)
+		assert.True(contains[kind]("text "+value+" text"), "%s should be found in %q", kind, value)
+		assert.True(Contains(value, kind))
+		for _, text := range negativeCorpus[f.name] {
+			assert.False(contains[kind](text), "%s should not be found in %q", kind, text)
+		}
+	}
+	assert.False(Contains("john@example.com", Kind("unknown")))
+}
+
+func TestContains_HasAny(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	assert.True(HasAny("mail john@example.com", KindIPv4, KindEmail))
+	assert.False(HasAny("mail john@example.com", KindIPv4, KindSSN))
+	assert.True(HasAny("at 9:45"))
+	assert.False(HasAny(""))
+}
@@ -0,0 +1,82 @@
+package commonregex
+
+// Chain identifies the blockchain a cryptocurrency address belongs to
+type Chain string
+
+// Supported chains
+const (
+	ChainBitcoin     Chain = "bitcoin"
+	ChainEthereum    Chain = "ethereum"
+	ChainLitecoin    Chain = "litecoin"
+	ChainBitcoinCash Chain = "bitcoin_cash"
+	ChainDogecoin    Chain = "dogecoin"
+	ChainMonero      Chain = "monero"
+	ChainSolana      Chain = "solana"
+	ChainTron        Chain = "tron"
+	ChainXRP         Chain = "xrp"
+)
+
+// cryptoKinds lists the address kinds with the chain they belong to and the
+// validator FindCryptoAddresses checks their matches with
+var cryptoKinds = []struct {
+	kind  Kind
+	chain Chain
+	valid func(string) bool
+}{
+	{KindBtcAddress, ChainBitcoin, ValidBtcAddress},
+	{KindEthAddress, ChainEthereum, ValidEthAddress},
+	{KindLtcAddress, ChainLitecoin, ValidLtcAddress},
+	{KindBchAddress, ChainBitcoinCash, ValidBchAddress},
+	{KindDogeAddress, ChainDogecoin, ValidDogeAddress},
+	{KindXmrAddress, ChainMonero, ValidXmrAddress},
+	{KindSolAddress, ChainSolana, ValidSolAddress},
+	{KindTrxAddress, ChainTron, ValidTrxAddress},
+	{KindXrpAddress, ChainXRP, ValidXrpAddress},
+}
+
+// CryptoAddress is a cryptocurrency address found by FindCryptoAddresses
+type CryptoAddress struct {
+	Chain   Chain
+	Kind    Kind
+	Address string
+	// Start and End are the offsets of the address in the text searched
+	Start, End int
+}
+
+// FindCryptoAddresses finds the cryptocurrency addresses in text whose
+// checksum is valid, tagged with their chain, in the order they appear.
+// Solana addresses carry no checksum, so a base58 string that decodes to 32
+// bytes is reported as one if a keyword such as "solana" or "wallet" is
+// nearby. The Limit, Unique, CaseFold, SortByFrequency, Normalized and
+// Deobfuscated options apply.
+func FindCryptoAddresses(text string, opts ...Option) []CryptoAddress {
+	o := newOptions(opts)
+	kinds := make([]Kind, len(cryptoKinds))
+	chains := make(map[Kind]int, len(cryptoKinds))
+	for i, c := range cryptoKinds {
+		kinds[i] = c.kind
+		chains[c.kind] = i
+	}
+
+	var found []CryptoAddress
+	var values []string
+	for _, m := range o.prepare(text).scan(kinds) {
+		c := cryptoKinds[chains[m.Kind]]
+		if !c.valid(m.Value) {
+			continue
+		}
+		found = append(found, CryptoAddress{
+			Chain:   c.chain,
+			Kind:    m.Kind,
+			Address: m.Value,
+			Start:   m.Start,
+			End:     m.End,
+		})
+		values = append(values, m.Value)
+		if o.limit > 0 && !o.unique && len(found) == o.limit {
+			break
+		}
+	}
+
+	return keepFound(o, found, values)
+}
@@ -0,0 +1,59 @@
+package commonregex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrypto_FindCryptoAddresses(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := `Send to 1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa or 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed,
+not 0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed. Also bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a,
+TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t and rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh.`
+
+	tests := []struct {
+		chain   Chain
+		kind    Kind
+		address string
+	}{
+		{ChainBitcoin, KindBtcAddress, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"},
+		{ChainEthereum, KindEthAddress, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{ChainBitcoinCash, KindBchAddress, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"},
+		{ChainTron, KindTrxAddress, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"},
+		{ChainXRP, KindXrpAddress, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+	}
+
+	found := FindCryptoAddresses(text)
+	if assert.Len(found, len(tests)) {
+		for i, test := range tests {
+			assert.Equal(string(test.chain), string(found[i].Chain))
+			assert.Equal(string(test.kind), string(found[i].Kind))
+			assert.Equal(test.address, found[i].Address)
+			assert.Equal(test.address, text[found[i].Start:found[i].End])
+		}
+	}
+
+	assert.Len(FindCryptoAddresses(text, Limit(2)), 2)
+	assert.Len(FindCryptoAddresses(text+" "+text, Unique()), len(tests))
+	assert.Empty(FindCryptoAddresses("0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+}
+
+func TestCrypto_FindCryptoAddressesNormalized(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	address := "44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A"
+	text := "wallet: " + address[:40] + "\u200b" + address[40:] + "."
+	assert.Empty(FindCryptoAddresses(text))
+
+	found := FindCryptoAddresses(text, Normalized())
+	if assert.Len(found, 1) {
+		assert.Equal(string(ChainMonero), string(found[0].Chain))
+		assert.Equal(address, found[0].Address)
+		assert.Equal(8, found[0].Start)
+		assert.Equal(len(text)-1, found[0].End)
+	}
+}
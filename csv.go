@@ -0,0 +1,194 @@
+package commonregex
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// defaultMinRatio is the share of a column's cells a kind must match for
+// ProfileCSV to guess that kind
+const defaultMinRatio = 0.5
+
+// CSVOptions describes the records read by ProfileCSV and RedactCSV
+type CSVOptions struct {
+	// Comma is the field delimiter, ',' if zero. Use '\t' for TSV.
+	Comma rune
+	// Header is set when the first record holds the column names. It is
+	// not profiled and is copied as is by RedactCSV.
+	Header bool
+	// Kinds are the kinds profiled. Every built-in kind is profiled if it is
+	// empty.
+	Kinds []Kind
+	// MaxRows is the number of records profiled, or every record if zero
+	MaxRows int
+	// MinRatio is the share of the non-empty cells of a column a kind must
+	// match entirely for the column to be guessed to hold that kind, 0.5 if
+	// zero
+	MinRatio float64
+	// Replacement is what RedactCSV replaces non-empty cells with,
+	// "[REDACTED]" if empty
+	Replacement string
+}
+
+func (o CSVOptions) reader(r io.Reader) *csv.Reader {
+	cr := csv.NewReader(r)
+	if o.Comma != 0 {
+		cr.Comma = o.Comma
+	}
+	cr.FieldsPerRecord = -1
+	return cr
+}
+
+// ColumnProfile tells which kinds the cells of a CSV column hold
+type ColumnProfile struct {
+	// Index is the position of the column, from 0
+	Index int
+	// Name is the column name from the header record, if any
+	Name string
+	// Cells is the number of non-empty cells in the column
+	Cells int
+	// Hits is the number of cells containing a match of each kind
+	Hits map[Kind]int
+	// Exact is the number of cells matched entirely, except for surrounding
+	// spaces, by each kind
+	Exact map[Kind]int
+	// Kind is the best guess of what the column holds: the kind matching
+	// the most cells entirely, if it matches at least MinRatio of them.
+	// Ties go to the kind selected first.
+	Kind Kind
+}
+
+// Ratio returns the share of the non-empty cells containing a match of kind
+func (c ColumnProfile) Ratio(kind Kind) float64 {
+	if c.Cells == 0 {
+		return 0
+	}
+	return float64(c.Hits[kind]) / float64(c.Cells)
+}
+
+// ProfileCSV reads CSV records from r and runs the kinds on every cell,
+// returning a profile of each column in column order
+func ProfileCSV(r io.Reader, opts CSVOptions) ([]ColumnProfile, error) {
+	cr := opts.reader(r)
+	infos := selectKinds(opts.Kinds)
+	var names []string
+	if opts.Header {
+		header, err := cr.Read()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		names = header
+	}
+
+	var profiles []ColumnProfile
+	column := func(i int) *ColumnProfile {
+		for len(profiles) <= i {
+			p := ColumnProfile{Index: len(profiles), Hits: make(map[Kind]int), Exact: make(map[Kind]int)}
+			if p.Index < len(names) {
+				p.Name = names[p.Index]
+			}
+			profiles = append(profiles, p)
+		}
+		return &profiles[i]
+	}
+	for i := range names {
+		column(i)
+	}
+
+	for rows := 0; opts.MaxRows == 0 || rows < opts.MaxRows; rows++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i, cell := range record {
+			p := column(i)
+			cell = strings.TrimSpace(cell)
+			if cell == "" {
+				continue
+			}
+			p.Cells++
+			for _, info := range infos {
+				if info.findAllIndex(cell, 1) == nil {
+					continue
+				}
+				p.Hits[info.kind]++
+				if isWhole(info, cell) {
+					p.Exact[info.kind]++
+				}
+			}
+		}
+	}
+
+	minRatio := opts.MinRatio
+	if minRatio == 0 {
+		minRatio = defaultMinRatio
+	}
+	for i := range profiles {
+		p := &profiles[i]
+		best := 0
+		for _, info := range infos {
+			if n := p.Exact[info.kind]; n > best {
+				best = n
+				p.Kind = info.kind
+			}
+		}
+		if p.Cells == 0 || float64(best)/float64(p.Cells) < minRatio {
+			p.Kind = ""
+		}
+	}
+	return profiles, nil
+}
+
+// isWhole reports whether a match of info spans all of cell
+func isWhole(info kindInfo, cell string) bool {
+	for _, loc := range info.findAllIndex(cell, -1) {
+		if loc[0] == 0 && loc[1] == len(cell) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactCSV copies the CSV records read from r to w, replacing every
+// non-empty cell of the given columns, counted from 0. The header record, if
+// opts.Header is set, is copied unchanged. Records are streamed one at a
+// time and may be quoted differently from the input.
+func RedactCSV(w io.Writer, r io.Reader, opts CSVOptions, columns ...int) error {
+	cr := opts.reader(r)
+	cr.ReuseRecord = true
+	cw := csv.NewWriter(w)
+	cw.Comma = cr.Comma
+	replacement := opts.Replacement
+	if replacement == "" {
+		replacement = "[REDACTED]"
+	}
+
+	for row := 0; ; row++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if row > 0 || !opts.Header {
+			for _, i := range columns {
+				if i >= 0 && i < len(record) && record[i] != "" {
+					record[i] = replacement
+				}
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
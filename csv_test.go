@@ -0,0 +1,93 @@
+package commonregex
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testCSV = `id,contact,ssn,card,notes
+1,john@example.com,123-45-6789,4111 1111 1111 1111,call 555-123-4567
+2,jane@example.com,234-56-7890,5500 0000 0000 0004,
+3,n/a,345-67-8901,,mail ops@example.com
+4,bob@example.org,,4012 8888 8888 1881,
+`
+
+func TestProfileCSV(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	profiles, err := ProfileCSV(strings.NewReader(testCSV), CSVOptions{Header: true})
+	assert.NoError(err)
+	assert.Len(profiles, 5)
+
+	tests := []struct {
+		name  string
+		cells int
+		kind  Kind
+	}{
+		{"id", 4, ""},
+		{"contact", 4, KindEmail},
+		{"ssn", 3, KindSSN},
+		{"card", 3, KindCreditCard},
+		{"notes", 2, ""},
+	}
+
+	for i, test := range tests {
+		assert.Equal(i, profiles[i].Index)
+		assert.Equal(test.name, profiles[i].Name)
+		assert.Equal(test.cells, profiles[i].Cells, test.name)
+		assert.Equal(test.kind, profiles[i].Kind, test.name)
+	}
+	assert.Equal(0.75, profiles[1].Ratio(KindEmail))
+	assert.Equal(1.0, profiles[2].Ratio(KindSSN))
+	assert.Equal(0.5, profiles[4].Ratio(KindEmail))
+	assert.Equal(0.0, profiles[4].Ratio(KindSSN))
+}
+
+func TestProfileCSV_Options(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tsv := strings.Replace(testCSV, ",", "\t", -1)
+	profiles, err := ProfileCSV(strings.NewReader(tsv), CSVOptions{
+		Comma:    '\t',
+		Kinds:    []Kind{KindEmail, KindSSN},
+		MaxRows:  2,
+		MinRatio: 1,
+	})
+	assert.NoError(err)
+	assert.Len(profiles, 5)
+	assert.Equal("", profiles[1].Name)
+	assert.Equal(2, profiles[1].Cells, "the header is profiled as a row")
+	assert.Equal(Kind(""), profiles[1].Kind)
+	assert.Equal(1, profiles[2].Exact[KindSSN])
+	assert.Equal(Kind(""), profiles[2].Kind, "one of two cells is below MinRatio")
+	assert.Empty(profiles[3].Hits)
+
+	profiles, err = ProfileCSV(strings.NewReader(`a,"b`), CSVOptions{})
+	assert.Error(err)
+	assert.Nil(profiles)
+}
+
+func TestRedactCSV(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	var out bytes.Buffer
+	err := RedactCSV(&out, strings.NewReader(testCSV), CSVOptions{Header: true}, 2, 3, 9)
+	assert.NoError(err)
+	assert.Equal(`id,contact,ssn,card,notes
+1,john@example.com,[REDACTED],[REDACTED],call 555-123-4567
+2,jane@example.com,[REDACTED],[REDACTED],
+3,n/a,[REDACTED],,mail ops@example.com
+4,bob@example.org,,[REDACTED],
+`, out.String())
+
+	out.Reset()
+	err = RedactCSV(&out, strings.NewReader("a@example.com\t\"x, y\"\n"), CSVOptions{Comma: '\t', Replacement: "***"}, 0)
+	assert.NoError(err)
+	assert.Equal("***\tx, y\n", out.String())
+}
@@ -0,0 +1,325 @@
+package commonregex
+
+import (
+	_ "embed" // for the date name table
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParsedDate is a date found by ParseDates
+type ParsedDate struct {
+	// Value is the date as written, like "23. März 2017"
+	Value string
+	// Year is the year of the date, or 0 if it does not give one. Two-digit
+	// years before 70 are in the 2000s, the others in the 1900s.
+	Year  int
+	Month time.Month
+	Day   int
+	// Start and End are the offsets of the date in the text searched
+	Start, End int
+}
+
+// dateNameTable lists the month and weekday names and the date orders of the
+// locales Date and ParseDates know
+//
+//go:embed data/dates.txt
+var dateNameTable string
+
+// dateLocale is how dates are written in a locale
+type dateLocale struct {
+	// months maps the lower case spellings of the months to them
+	months   map[string]time.Month
+	weekdays []string
+	// order is the order of the day, month and year in numeric dates:
+	// "dmy", "mdy" or "ymd"
+	order string
+	// yearSuffix, monthSuffix and daySuffix are written after the numbers of
+	// a date by locales without month names
+	yearSuffix, monthSuffix, daySuffix string
+	// regex finds the dates of the locale, or is nil for English, whose dates
+	// DateRegex finds
+	regex *regexp.Regexp
+}
+
+var (
+	dateLocales     map[Locale]*dateLocale
+	dateLocalesOnce sync.Once
+)
+
+// loadDateLocales parses the table and builds the regular expressions of the
+// locales on first use
+func loadDateLocales() {
+	dateLocales = make(map[Locale]*dateLocale)
+	for _, line := range strings.Split(dateNameTable, "\n") {
+		fields := strings.Split(line, "|")
+		if len(fields) != 3 || strings.HasPrefix(line, "//") {
+			continue
+		}
+		locale := Locale(strings.TrimSpace(fields[0]))
+		dl := dateLocales[locale]
+		if dl == nil {
+			dl = &dateLocale{months: make(map[string]time.Month)}
+			dateLocales[locale] = dl
+		}
+		value := strings.TrimSpace(fields[2])
+		switch strings.TrimSpace(fields[1]) {
+		case "months":
+			for i, names := range strings.Split(value, ",") {
+				for _, name := range strings.Fields(names) {
+					dl.months[name] = time.Month(i + 1)
+				}
+			}
+		case "weekdays":
+			for _, names := range strings.Split(value, ",") {
+				dl.weekdays = append(dl.weekdays, strings.Fields(names)...)
+			}
+		case "order":
+			dl.order = value
+		case "year":
+			dl.yearSuffix = value
+		case "month":
+			dl.monthSuffix = value
+		case "day":
+			dl.daySuffix = value
+		}
+	}
+	for locale, dl := range dateLocales {
+		if locale != LocaleEnglish {
+			dl.regex = regexp.MustCompile(dl.pattern())
+		}
+	}
+}
+
+// alternation returns a pattern matching any of the names, the longest first
+func alternation(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = regexp.QuoteMeta(name)
+	}
+	sort.SliceStable(quoted, func(i, j int) bool {
+		return len(quoted[i]) > len(quoted[j])
+	})
+	return `(?:` + strings.Join(quoted, `|`) + `)`
+}
+
+// pattern returns the regular expression of the dates of the locale: written
+// with the month name, or with the year, month and day suffixes, and numeric
+func (dl *dateLocale) pattern() string {
+	ymd := `\d{4}[-./]\s?[01]?\d[-./]\s?[0-3]?\d`
+	if dl.order == "ymd" {
+		var long []string
+		for _, name := range dl.weekdays {
+			if utf8.RuneCountInString(name) > 1 {
+				long = append(long, name)
+			}
+		}
+		return `(?i)(?:\d{4}\s*` + regexp.QuoteMeta(dl.yearSuffix) + `\s*)?[01]?\d\s*` + regexp.QuoteMeta(dl.monthSuffix) +
+			`\s*[0-3]?\d\s*` + regexp.QuoteMeta(dl.daySuffix) +
+			`(?:\s*[(（]` + alternation(dl.weekdays) + `[)）]|\s*` + alternation(long) + `)?|` + ymd
+	}
+
+	months := make([]string, 0, len(dl.months))
+	for name := range dl.months {
+		months = append(months, name)
+	}
+	sort.Strings(months)
+	return `(?i)(?:` + alternation(dl.weekdays) + `\.?,?\s+)?[0-3]?\d(?:\.|er|º|°)?(?:\s+de)?\s+` + alternation(months) +
+		`(?:\.?,?(?:\s+de)?\s+\d{4})?|` + ymd + `|[0-3]?\d[-./][01]?\d[-./](?:\d{4}|\d{2})`
+}
+
+// lookupDateLocale returns how dates are written in the locale, or in
+// English if it is not known
+func lookupDateLocale(locale Locale) *dateLocale {
+	dateLocalesOnce.Do(loadDateLocales)
+	if dl, ok := dateLocales[locale.language()]; ok {
+		return dl
+	}
+	return dateLocales[LocaleEnglish]
+}
+
+// wholeDate reports whether the date text[start:end] is not cut from a longer
+// number or word, as DatePattern would cut "2017-03-23" from "2017-03-234":
+// a date ending in a digit may only run on into the "T" of an ISO 8601 time,
+// like "2017-03-23T09:45:00Z"
+func wholeDate(text string, start, end int) bool {
+	last := text[end-1]
+	return end == len(text) || last < '0' || '9' < last ||
+		text[end] == 'T' || !isWordByte(text[end])
+}
+
+// findDates returns the offsets of the dates written in text as the locale
+// writes them
+func findDates(text string, locale Locale) [][]int {
+	dl := lookupDateLocale(locale)
+	if dl.regex == nil {
+		var locs [][]int
+		for _, loc := range DateRegex.FindAllStringIndex(text, -1) {
+			if wholeDate(text, loc[0], loc[1]) {
+				locs = append(locs, loc)
+			}
+		}
+		return locs
+	}
+
+	// words run on without spaces in the locales writing dates with
+	// suffixes, so only digits may not touch those dates
+	joined := func(r rune) bool {
+		return unicode.IsDigit(r) || dl.order != "ymd" && unicode.IsLetter(r)
+	}
+	var locs [][]int
+	for pos := 0; pos < len(text); {
+		loc := dl.regex.FindStringIndex(text[pos:])
+		if loc == nil {
+			break
+		}
+		start, end := pos+loc[0], pos+loc[1]
+		prev, _ := utf8.DecodeLastRuneInString(text[:start])
+		next, _ := utf8.DecodeRuneInString(text[end:])
+		if start > 0 && joined(prev) || end < len(text) && joined(next) {
+			pos = end
+			if first, size := utf8.DecodeRuneInString(text[start:]); unicode.IsLetter(first) {
+				// the match may have taken the end of a word for a weekday,
+				// like "so" in "also 3. Mai", so look again after it
+				pos = start + size
+			}
+			continue
+		}
+		locs = append(locs, []int{start, end})
+		pos = end
+	}
+	return locs
+}
+
+// dateToken is a run of digits or of letters in a date
+type dateToken struct {
+	text   string
+	digits bool
+}
+
+func dateTokens(s string) []dateToken {
+	var tokens []dateToken
+	start := -1
+	digits := false
+	for i, r := range s + " " {
+		isDigit := '0' <= r && r <= '9'
+		if start >= 0 && (isDigit != digits || !isDigit && !unicode.IsLetter(r)) {
+			tokens = append(tokens, dateToken{s[start:i], digits})
+			start = -1
+		}
+		if start < 0 && (isDigit || unicode.IsLetter(r)) {
+			start, digits = i, isDigit
+		}
+	}
+	return tokens
+}
+
+// parseDate reads a date found by findDates in the locale
+func (dl *dateLocale) parseDate(s string) (year int, month time.Month, day int, ok bool) {
+	tokens := dateTokens(s)
+	var numbers []string
+	for i, token := range tokens {
+		if !token.digits {
+			// a weekday written before the date may also be a month, like
+			// "mar" in Spanish, so the last month name wins
+			if m, ok := dl.months[strings.ToLower(token.text)]; ok {
+				month = m
+			}
+			continue
+		}
+		n, _ := strconv.Atoi(token.text)
+		suffix := ""
+		if i+1 < len(tokens) && !tokens[i+1].digits {
+			suffix = tokens[i+1].text
+		}
+		switch {
+		case dl.yearSuffix != "" && strings.HasPrefix(suffix, dl.yearSuffix):
+			year = n
+		case dl.monthSuffix != "" && strings.HasPrefix(suffix, dl.monthSuffix):
+			month = time.Month(n)
+		case dl.daySuffix != "" && strings.HasPrefix(suffix, dl.daySuffix):
+			day = n
+		default:
+			numbers = append(numbers, token.text)
+		}
+	}
+
+	switch {
+	case month != 0 && day != 0:
+	case month != 0:
+		// a day and a year of four digits, in either order
+		for _, number := range numbers {
+			n, _ := strconv.Atoi(number)
+			if len(number) == 4 {
+				year = n
+			} else if day == 0 {
+				day = n
+			}
+		}
+	case len(numbers) == 3:
+		order := dl.order
+		if len(numbers[0]) == 4 {
+			order = "ymd"
+		}
+		fields := make(map[byte]int, 3)
+		for i := range numbers {
+			fields[order[i]], _ = strconv.Atoi(numbers[i])
+			if order[i] == 'y' && len(numbers[i]) == 2 {
+				fields['y'] += 1900
+				if fields['y'] < 1970 {
+					fields['y'] += 100
+				}
+			}
+		}
+		year, month, day = fields['y'], time.Month(fields['m']), fields['d']
+	}
+
+	// a date without a year may be the 29th of February
+	check := year
+	if check == 0 {
+		check = 2000
+	}
+	if month < time.January || month > time.December || day < 1 ||
+		time.Date(check, month, day, 0, 0, 0, 0, time.UTC).Day() != day {
+		return 0, 0, 0, false
+	}
+	return year, month, day, true
+}
+
+// ParseDates finds the dates in text, like Date, and reads their year, month
+// and day. Dates are written as in English unless another locale is given
+// with InLocale: French, German, Spanish, Italian, Dutch and Portuguese dates
+// with month names, like "23 mars 2017" or "Donnerstag, 23. März 2017",
+// Korean and Japanese dates, like "2017년 3월 23일" or "2017年3月23日", and
+// numeric dates in the order of the locale. Dates which do not exist, like
+// "30 février", are skipped. The Limit, Unique, CaseFold, SortByFrequency,
+// Normalized, Deobfuscated and InLocale options apply.
+func ParseDates(text string, opts ...Option) []ParsedDate {
+	o := newOptions(opts)
+	m := o.prepare(text)
+	prepared := m.String()
+	dl := lookupDateLocale(o.locale)
+
+	var found []ParsedDate
+	var values []string
+	for _, loc := range findDates(prepared, o.locale) {
+		value := prepared[loc[0]:loc[1]]
+		year, month, day, ok := dl.parseDate(value)
+		if !ok {
+			continue
+		}
+		start, end := m.span(loc[0], loc[1])
+		found = append(found, ParsedDate{Value: value, Year: year, Month: month, Day: day, Start: start, End: end})
+		values = append(values, value)
+		if o.limit > 0 && !o.unique && len(found) == o.limit {
+			break
+		}
+	}
+
+	return keepFound(o, found, values)
+}
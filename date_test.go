@@ -0,0 +1,122 @@
+package commonregex
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate_InLocale(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []struct {
+		locale Locale
+		text   string
+		dates  []string
+	}{
+		{LocaleFrench, "Le jeudi 23 mars 2017, puis le 1er avril et le 23/03/2017.", []string{"jeudi 23 mars 2017", "1er avril", "23/03/2017"}},
+		{LocaleFrench, "le 3 févr. 2017 et le 14 JUILLET", []string{"3 févr. 2017", "14 JUILLET"}},
+		{LocaleGerman, "Am Donnerstag, 23. März 2017 und am 24.03.17", []string{"Donnerstag, 23. März 2017", "24.03.17"}},
+		{"de-AT", "am 2. Jänner 2018", []string{"2. Jänner 2018"}},
+		{LocaleSpanish, "el martes, 23 de marzo de 2017 y el 1º de mayo", []string{"martes, 23 de marzo de 2017", "1º de mayo"}},
+		{LocaleItalian, "giovedì 23 marzo 2017", []string{"giovedì 23 marzo 2017"}},
+		{LocaleDutch, "op donderdag 23 maart 2017", []string{"donderdag 23 maart 2017"}},
+		{LocalePortuguese, "segunda-feira, 5 de março de 2018", []string{"segunda-feira, 5 de março de 2018"}},
+		{LocaleKorean, "회의는 2017년 3월 23일 (목), 3월 24일 금요일", []string{"2017년 3월 23일 (목)", "3월 24일 금요일"}},
+		{LocaleJapanese, "本日2017年3月23日(木)です。3月24日金曜日と2017/3/25", []string{"2017年3月23日(木)", "3月24日金曜日", "2017/3/25"}},
+		{LocaleGerman, "also 3. Mai 2017, Büro 4. Mai 2017", []string{"3. Mai 2017", "4. Mai 2017"}},
+		{LocaleFrench, "demain mardi 4 avril et samedi 8 avril", []string{"mardi 4 avril", "samedi 8 avril"}},
+		{LocaleDutch, "zo 2 april en dinsdag 4 april", []string{"zo 2 april", "dinsdag 4 april"}},
+		{LocaleEnglish, "by Jan 9th 2012 or 3-23-17", []string{"Jan 9th 2012", "3-23-17"}},
+		{"xx", "by Jan 9th 2012 or 3-23-17", []string{"Jan 9th 2012", "3-23-17"}},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.dates, Date(test.text, InLocale(test.locale)), "dates in %q in %s", test.text, test.locale)
+	}
+
+	failingTests := []struct {
+		locale Locale
+		text   string
+	}{
+		{LocaleFrench, "23 marsupiaux"},
+		{LocaleFrench, "Jan 9th 2012"},
+		{LocaleGerman, "123. März"},
+		{LocaleGerman, "v1.2.3"},
+		{LocaleJapanese, "12017年3月23日"},
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(Date(test.text, InLocale(test.locale)), "no dates in %q in %s", test.text, test.locale)
+	}
+
+	assert.Len(Date("23 mars, 24 mars, 23 mars", InLocale(LocaleFrench), Unique()), 2)
+	assert.Len(Date("23 mars, 24 mars, 25 mars", InLocale(LocaleFrench), Limit(2)), 2)
+}
+
+func TestDate_ParseDates(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []struct {
+		locale Locale
+		value  string
+		year   int
+		month  time.Month
+		day    int
+	}{
+		{LocaleEnglish, "Jan 9th 2012", 2012, time.January, 9},
+		{LocaleEnglish, "23 Mar 2017", 2017, time.March, 23},
+		{LocaleEnglish, "3-23-17", 2017, time.March, 23},
+		{LocaleEnglish, "12/31/99", 1999, time.December, 31},
+		{LocaleEnglish, "2017-03-23", 2017, time.March, 23},
+		{LocaleEnglish, "March 23th", 0, time.March, 23},
+		{LocaleFrench, "jeudi 23 mars 2017", 2017, time.March, 23},
+		{LocaleFrench, "1er août", 0, time.August, 1},
+		{LocaleFrench, "23/03/2017", 2017, time.March, 23},
+		{LocaleGerman, "Donnerstag, 23. März 2017", 2017, time.March, 23},
+		{LocaleGerman, "24.03.17", 2017, time.March, 24},
+		{LocaleSpanish, "mar, 23 de marzo de 2017", 2017, time.March, 23},
+		{LocaleSpanish, "lunes, 1 de mar de 2021", 2021, time.March, 1},
+		{LocaleItalian, "29 febbraio", 0, time.February, 29},
+		{LocaleDutch, "23 mrt 2017", 2017, time.March, 23},
+		{LocalePortuguese, "5 de março de 2018", 2018, time.March, 5},
+		{LocaleKorean, "2017년 3월 23일 (목)", 2017, time.March, 23},
+		{LocaleKorean, "2017. 3. 23", 2017, time.March, 23},
+		{LocaleJapanese, "2017年3月23日木曜日", 2017, time.March, 23},
+		{LocaleJapanese, "12月1日", 0, time.December, 1},
+	}
+
+	for _, test := range tests {
+		found := ParseDates(test.value, InLocale(test.locale))
+		if assert.Len(found, 1, "dates in %q in %s", test.value, test.locale) {
+			assert.Equal(test.value, found[0].Value)
+			assert.Equal(test.year, found[0].Year, "year of %q", test.value)
+			assert.Equal(test.month, found[0].Month, "month of %q", test.value)
+			assert.Equal(test.day, found[0].Day, "day of %q", test.value)
+		}
+	}
+
+	failingTests := []struct {
+		locale Locale
+		value  string
+	}{
+		{LocaleEnglish, "Feb 30 2017"},
+		{LocaleEnglish, "13-23-17"},
+		{LocaleFrench, "30 février 2017"},
+		{LocaleGerman, "29. Februar 2017"},
+		{LocaleJapanese, "2017年13月1日"},
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(ParseDates(test.value, InLocale(test.locale)), "%q in %s should not be parsed", test.value, test.locale)
+	}
+
+	text := "Réunion le 23 mars 2017 à Paris"
+	found := ParseDates(text, InLocale(LocaleFrench))
+	if assert.Len(found, 1) {
+		assert.Equal("23 mars 2017", text[found[0].Start:found[0].End])
+	}
+}
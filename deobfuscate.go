@@ -0,0 +1,197 @@
+package commonregex
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// bracketedSymbolRegex matches a symbol or its name in brackets, like
+	// "[at]", "(dot)" or "[:]", along with the spaces around it
+	bracketedSymbolRegex = regexp.MustCompile(`(?i)(\s*)[\[({<]\s*(at|@|dot|\.|:|://|/)\s*[\])}>](\s*)`)
+	// bracketedDomainRegex matches the start of a domain after a bracketed
+	// "at" set apart by spaces: a label followed by a dot, bracketed or
+	// spelled out
+	bracketedDomainRegex = regexp.MustCompile(`(?i)^[\w-]+(?:\.|\s*[\[({<]\s*(?:dot|\.)\s*[\])}>]\s*|\s+dot\s+)[\w-]`)
+	// defangedSchemeRegex matches the defanged schemes of URLs
+	defangedSchemeRegex = regexp.MustCompile(`(?i)\bhxxp(s?)\b`)
+	// spelledEmailRegex matches an email address with "at" and "dot" spelled
+	// out between spaces, like "harold dot smith at gmail dot com". The dot
+	// before the top-level domain must be spelled out too, as "at" before a
+	// domain, like "find us at example.com", is mostly prose.
+	spelledEmailRegex = regexp.MustCompile(`(?i)\b[\w.+-]+(?:\s+dot\s+[\w+-]+)*\s+at\s+[\w-]+(?:(?:\s+dot\s+|\.)[\w-]+)*\s+dot\s+[a-z]{2,}\b`)
+	// spelledDomainRegex matches a domain with "dot" spelled out between
+	// spaces, like "example dot com", ending in a common top-level domain
+	spelledDomainRegex = regexp.MustCompile(`(?i)\b[\w-]+(?:\s+dot\s+[\w-]+)*\s+dot\s+(?:com|net|org|edu|gov|mil|int|info|biz|io|co|me|us|uk|ca|au|de|fr|jp|ru|cn|in|nl|br|it|es)\b`)
+	// spelledSeparatorRegex matches "at" or "dot" spelled out between spaces
+	spelledSeparatorRegex = regexp.MustCompile(`(?i)\s+(at|dot)\s+`)
+	// spelledDigitsRegex matches a run of digits and digit names
+	spelledDigitsRegex = regexp.MustCompile(`(?i)\b(?:zero|one|two|three|four|five|six|seven|eight|nine|\d+)(?:[\s-]+(?:zero|one|two|three|four|five|six|seven|eight|nine|\d+))+\b`)
+	// digitTokenRegex matches a digit name or a number in a run
+	digitTokenRegex = regexp.MustCompile(`[a-zA-Z]+|\d+`)
+	// phoneKeywordRegex matches a word announcing a phone number at the end
+	// of the text before a run of digits
+	phoneKeywordRegex = regexp.MustCompile(`(?i)\b(?:call|phone|tel|telephone|dial|text|mobile|cell|fax|number)\b\W*$`)
+)
+
+// digitNames maps the names of the digits to the digits
+var digitNames = map[string]string{
+	"zero": "0", "one": "1", "two": "2", "three": "3", "four": "4",
+	"five": "5", "six": "6", "seven": "7", "eight": "8", "nine": "9",
+}
+
+// minSpelledDigits is the number of digit names a run must have to be
+// rewritten, so that prose like "one or two" is left alone
+const minSpelledDigits = 3
+
+// minPhoneDigits is the number of digits a run must have to be rewritten
+// without a phone keyword before it, so that prose like "one two three
+// apples" is left alone
+const minPhoneDigits = 7
+
+// Deobfuscate rewrites the common obfuscations of email addresses, phone
+// numbers and links in text so the finders catch them:
+//
+//   - symbols in brackets, like "harold[at]gmail[.]com" or "hxxp[:]//"
+//   - "at" and "dot" spelled out, like "harold dot smith at gmail dot com"
+//     or "example dot com"
+//   - defanged schemes, like "hxxps://"
+//   - spelled-out digits in a phone number, like "five five five 1234" or
+//     "dial nine one one"
+//
+// Use ScanDeobfuscated to find the offsets of the matches in the original
+// text, or the Deobfuscated option of the finders.
+func Deobfuscate(text string) string {
+	return deobfuscate(newMappedText(text)).String()
+}
+
+// ScanDeobfuscated finds the matches of the given kinds, or of every built-in
+// kind if none are given, in the text rewritten by Deobfuscate. Value holds the
+// rewritten match while Start and End are the offsets of its source in text.
+func ScanDeobfuscated(text string, kinds ...Kind) []Match {
+	return deobfuscate(newMappedText(text)).scan(kinds)
+}
+
+// deobfuscate rewrites text in passes: symbols first, so the spelled-out
+// forms can be recognized next to them, then spelled-out separators and
+// digits
+func deobfuscate(m *mappedText) *mappedText {
+	m = refang(m)
+	text := m.String()
+	var edits []edit
+	for _, regex := range []*regexp.Regexp{spelledEmailRegex, spelledDomainRegex} {
+		for _, loc := range regex.FindAllStringIndex(text, -1) {
+			for _, sep := range spelledSeparatorRegex.FindAllStringSubmatchIndex(text[loc[0]:loc[1]], -1) {
+				symbol := "."
+				if strings.EqualFold(text[loc[0]+sep[2]:loc[0]+sep[3]], "at") {
+					symbol = "@"
+				}
+				edits = append(edits, edit{loc[0] + sep[0], loc[0] + sep[1], symbol})
+			}
+		}
+	}
+	for _, loc := range spelledDigitsRegex.FindAllStringIndex(text, -1) {
+		edits = append(edits, spelledDigitEdits(text, loc[0], loc[1])...)
+	}
+	return m.rewrite(sortEdits(edits))
+}
+
+// refang rewrites the symbols in brackets and the defanged schemes in the
+// text of m
+func refang(m *mappedText) *mappedText {
+	text := m.String()
+	var edits []edit
+	for _, loc := range bracketedSymbolRegex.FindAllStringSubmatchIndex(text, -1) {
+		symbol := strings.ToLower(text[loc[4]:loc[5]])
+		if loc[3] > loc[2] || loc[7] > loc[6] {
+			if !inAddress(text, loc[0], loc[1], symbol) {
+				continue
+			}
+		}
+		switch symbol {
+		case "at":
+			symbol = "@"
+		case "dot":
+			symbol = "."
+		}
+		edits = append(edits, edit{loc[0], loc[1], symbol})
+	}
+	for _, loc := range defangedSchemeRegex.FindAllStringSubmatchIndex(text, -1) {
+		edits = append(edits, edit{loc[0], loc[1], "http" + text[loc[2]:loc[3]]})
+	}
+	return m.rewrite(sortEdits(edits))
+}
+
+// inAddress reports whether the bracketed symbol set apart by spaces in
+// text[start:end] joins two parts of an address, so that the spaces can be
+// dropped. An "at" must be followed by a domain, so that prose like
+// "meet (at) 5" is left alone.
+func inAddress(text string, start, end int, symbol string) bool {
+	if start == 0 || end == len(text) || !isAddressByte(text[start-1]) || !isAddressByte(text[end]) {
+		return false
+	}
+	return symbol != "at" && symbol != "@" || bracketedDomainRegex.MatchString(text[end:])
+}
+
+// isAddressByte reports whether b can end or start a part of an email
+// address or link
+func isAddressByte(b byte) bool {
+	return isWordByte(b) || b == '-'
+}
+
+// spelledDigitEdits returns the edits turning the digit names in
+// text[from:to] into digits, if it looks like a phone number: with enough
+// digits or a phone keyword before it. Spaces between two digit names are
+// dropped, so "five five five 1234" becomes "555 1234".
+func spelledDigitEdits(text string, from, to int) []edit {
+	tokens := digitTokenRegex.FindAllStringIndex(text[from:to], -1)
+	names, digits := 0, 0
+	for _, t := range tokens {
+		token := text[from+t[0] : from+t[1]]
+		if _, ok := digitNames[strings.ToLower(token)]; ok {
+			names++
+			digits++
+		} else {
+			digits += len(token)
+		}
+	}
+	before := text[:from]
+	if len(before) > contextWindow {
+		before = before[len(before)-contextWindow:]
+	}
+	if names < minSpelledDigits || digits < minPhoneDigits && !phoneKeywordRegex.MatchString(before) {
+		return nil
+	}
+
+	var edits []edit
+	prevName := false
+	for i, t := range tokens {
+		digit, isName := digitNames[strings.ToLower(text[from+t[0]:from+t[1]])]
+		if isName && prevName {
+			edits = append(edits, edit{from + tokens[i-1][1], from + t[0], ""})
+		}
+		if isName {
+			edits = append(edits, edit{from + t[0], from + t[1], digit})
+		}
+		prevName = isName
+	}
+	return edits
+}
+
+// sortEdits sorts edits and drops those overlapping an earlier one
+func sortEdits(edits []edit) []edit {
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].from < edits[j].from
+	})
+	var kept []edit
+	end := 0
+	for _, e := range edits {
+		if e.from < end {
+			continue
+		}
+		kept = append(kept, e)
+		end = e.to
+	}
+	return kept
+}
@@ -0,0 +1,76 @@
+package commonregex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeobfuscate(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []struct {
+		text, want string
+	}{
+		{"harold dot smith at gmail dot com", "harold.smith@gmail.com"},
+		{"harold[at]gmail[.]com", "harold@gmail.com"},
+		{"harold (at) gmail (dot) com", "harold@gmail.com"},
+		{"harold {@} gmail [dot] com", "harold@gmail.com"},
+		{"mail harold.smith AT gmail dot com", "mail harold.smith@gmail.com"},
+		{"see example dot com for more", "see example.com for more"},
+		{"hxxps[:]//evil[.]example[.]com/x", "https://evil.example.com/x"},
+		{"hXXp://example.com", "http://example.com"},
+		{"call five five five 1234", "call 555 1234"},
+		{"call five-five-five one two three four", "call 5551234"},
+		{"Dial nine one one", "Dial 911"},
+		{"phone: one two three", "phone: 123"},
+		{"one or two at most", "one or two at most"},
+		{"we met at noon. Then", "we met at noon. Then"},
+		{"meet me at 5.30", "meet me at 5.30"},
+		{"connect the dot to the next", "connect the dot to the next"},
+		{"plain text", "plain text"},
+		{"Find us at example.com", "Find us at example.com"},
+		{"read the docs at golang.org today", "read the docs at golang.org today"},
+		{"mail harold.smith AT gmail.com", "mail harold.smith AT gmail.com"},
+		{"one two three apples", "one two three apples"},
+		{"Nine one one", "Nine one one"},
+		{"meet (at) 5", "meet (at) 5"},
+		{"ask (at) the desk (dot)", "ask (at) the desk (dot)"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.want, Deobfuscate(test.text), test.text)
+	}
+}
+
+func TestScanDeobfuscated(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := "Contact harold dot smith at gmail dot com or five five five 1234."
+	matches := ScanDeobfuscated(text, KindEmail, KindPhone)
+	assert.Equal([]Match{
+		{KindEmail, "harold.smith@gmail.com", 8, 41},
+		{KindPhone, "555 1234", 45, 64},
+	}, matches)
+	assert.Equal("harold dot smith at gmail dot com", text[8:41])
+	assert.Equal("five five five 1234", text[45:64])
+
+	text = "harold[at]gmail[.]com"
+	matches = ScanDeobfuscated(text, KindEmail)
+	assert.Equal([]Match{{KindEmail, "harold@gmail.com", 0, len(text)}}, matches)
+}
+
+func TestOptions_Deobfuscated(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := "harold[at]gmail[.]com, jane at example dot org, hxxp://example[.]net/x"
+
+	assert.Nil(Emails(text))
+	assert.Equal([]string{"harold@gmail.com", "jane@example.org"}, Emails(text, Deobfuscated()))
+	assert.Contains(Links(text, Deobfuscated()), "http://example.net/x")
+	assert.Equal([]string{"555 1234"}, Phones("five five five 1234", Deobfuscated()))
+	assert.Nil(Emails("Find us at example.com, or the docs at golang.org", Deobfuscated()))
+}
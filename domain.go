@@ -0,0 +1,205 @@
+package commonregex
+
+import (
+	_ "embed" // for the public suffix list
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// publicSuffixList is the ICANN section of the Public Suffix List
+//
+//go:embed data/public_suffix_list.dat
+var publicSuffixList string
+
+var (
+	publicSuffixes     map[string]bool
+	publicSuffixesOnce sync.Once
+)
+
+// loadPublicSuffixes parses the list on first use, keeping the rules as
+// written: "co.uk", "*.ck" or "!www.ck"
+func loadPublicSuffixes() {
+	publicSuffixes = make(map[string]bool)
+	for _, line := range strings.Split(publicSuffixList, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+		publicSuffixes[line] = true
+	}
+}
+
+// hostCandidateRegex matches dotted sequences of labels, which ParseDomain
+// then checks against the public suffix list
+var hostCandidateRegex = regexp.MustCompile(`[\p{L}\p{N}](?:[\p{L}\p{N}-]*[\p{L}\p{N}])?(?:\.[\p{L}\p{N}](?:[\p{L}\p{N}-]*[\p{L}\p{N}])?)+`)
+
+// Limits on host names from RFC 1035
+const (
+	maxHostLength  = 253
+	maxLabelLength = 63
+)
+
+// fileExtensions are the extensions ExcludeFileExtensions skips names ending
+// in. Some, like .md, .py or .zip, are also top-level domains.
+var fileExtensions = map[string]bool{
+	"bak": true, "bat": true, "bin": true, "bz2": true, "c": true, "cfg": true,
+	"conf": true, "cpp": true, "cs": true, "css": true, "csv": true, "dat": true,
+	"db": true, "dll": true, "doc": true, "docx": true, "exe": true, "gif": true,
+	"go": true, "gz": true, "h": true, "htm": true, "html": true, "ini": true,
+	"iso": true, "jar": true, "java": true, "jpeg": true, "jpg": true, "js": true,
+	"json": true, "kt": true, "lock": true, "log": true, "md": true, "mod": true,
+	"mov": true, "mp3": true, "mp4": true, "pdf": true, "php": true, "pl": true,
+	"png": true, "ppt": true, "pptx": true, "ps1": true, "py": true, "rb": true,
+	"rs": true, "sh": true, "so": true, "sql": true, "sum": true, "svg": true,
+	"swift": true, "tar": true, "tgz": true, "tmp": true, "toml": true, "ts": true,
+	"txt": true, "wav": true, "xls": true, "xlsx": true, "xml": true, "yaml": true,
+	"yml": true, "zip": true,
+}
+
+// ExcludeFileExtensions makes Domains and FindDomains skip names ending in a
+// known file extension, like main.go, notes.txt or README.md, even where the
+// extension is a top-level domain. Other finders ignore it.
+func ExcludeFileExtensions() Option {
+	return func(o *options) {
+		o.excludeFileExtensions = true
+	}
+}
+
+// Domain is a host name split at its public suffix. Host, Subdomain,
+// Registrable and Suffix are written as found, so that for www.Example.co.uk
+// the suffix is "co.uk", the registrable domain "Example.co.uk" and the
+// subdomain "www".
+type Domain struct {
+	Host string
+	// Subdomain is the part of the host left of the registrable domain, or
+	// empty if there is none
+	Subdomain string
+	// Registrable is the public suffix and the label before it, the eTLD+1
+	Registrable string
+	// Suffix is the public suffix, the eTLD
+	Suffix string
+	// ASCII is the lowercase host with its internationalized labels
+	// encoded as punycode, like xn--e1afmkfd.xn--p1ai for пример.рф
+	ASCII string
+	// Start and End are the offsets of the host in the text searched, zero
+	// for ParseDomain
+	Start, End int
+}
+
+// ParseDomain splits host at its public suffix. Internationalized labels may be
+// written in Unicode or as punycode. It reports false if the host does not
+// end in a known suffix or has no label before it.
+func ParseDomain(host string) (Domain, bool) {
+	if len(host) > maxHostLength {
+		return Domain{}, false
+	}
+	labels := strings.Split(host, ".")
+	for _, label := range labels {
+		if !isHostLabel(label) {
+			return Domain{}, false
+		}
+	}
+	unicodeHost, err := hostToUnicode(host)
+	if err != nil {
+		return Domain{}, false
+	}
+	n := publicSuffixLabels(strings.Split(unicodeHost, "."))
+	if n == 0 || n >= len(labels) {
+		return Domain{}, false
+	}
+
+	d := Domain{
+		Host:        host,
+		Suffix:      strings.Join(labels[len(labels)-n:], "."),
+		Registrable: strings.Join(labels[len(labels)-n-1:], "."),
+		ASCII:       hostToASCII(unicodeHost),
+	}
+	if len(labels) > n+1 {
+		d.Subdomain = strings.Join(labels[:len(labels)-n-1], ".")
+	}
+	return d, true
+}
+
+// isHostLabel reports whether label is made of letters, digits and inner
+// hyphens and fits the length limit
+func isHostLabel(label string) bool {
+	if label == "" || len(label) > maxLabelLength || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for _, r := range label {
+		if r != '-' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// publicSuffixLabels returns the number of labels of the public suffix of a
+// lowercase host, or 0 if its top-level domain is not listed. Exception rules
+// take precedence, then the longest matching rule, as the list specifies.
+func publicSuffixLabels(labels []string) int {
+	publicSuffixesOnce.Do(loadPublicSuffixes)
+	best := 0
+	for n := 1; n <= len(labels); n++ {
+		suffix := strings.Join(labels[len(labels)-n:], ".")
+		if publicSuffixes["!"+suffix] {
+			return n - 1
+		}
+		if publicSuffixes[suffix] {
+			best = n
+		}
+		if n > 1 && publicSuffixes["*."+strings.Join(labels[len(labels)-n+1:], ".")] {
+			best = n
+		}
+	}
+	return best
+}
+
+// FindDomains finds the host names in text whose top-level domain is in the
+// embedded public suffix list. Version numbers, file names with extensions
+// that are not top-level domains and the like are skipped. The Limit, Unique,
+// CaseFold, SortByFrequency, Normalized, Deobfuscated and
+// ExcludeFileExtensions options apply.
+func FindDomains(text string, opts ...Option) []Domain {
+	o := newOptions(opts)
+	m := o.prepare(text)
+	prepared := m.String()
+
+	var domains []Domain
+	var hosts []string
+	for _, loc := range hostCandidateRegex.FindAllStringIndex(prepared, -1) {
+		if loc[0] > 0 && (prepared[loc[0]-1] == '_' || prepared[loc[0]-1] == '-') ||
+			loc[1] < len(prepared) && (prepared[loc[1]] == '_' || prepared[loc[1]] == '-') {
+			continue
+		}
+		host := prepared[loc[0]:loc[1]]
+		if o.excludeFileExtensions {
+			if ext := host[strings.LastIndexByte(host, '.')+1:]; fileExtensions[strings.ToLower(ext)] {
+				continue
+			}
+		}
+		d, ok := ParseDomain(host)
+		if !ok {
+			continue
+		}
+		d.Start, d.End = m.span(loc[0], loc[1])
+		domains = append(domains, d)
+		hosts = append(hosts, host)
+		if o.limit > 0 && !o.unique && len(domains) == o.limit {
+			break
+		}
+	}
+
+	return keepFound(o, domains, hosts)
+}
+
+// Domains returns the host names in text, like FindDomains
+func Domains(text string, opts ...Option) []string {
+	var hosts []string
+	for _, d := range FindDomains(text, opts...) {
+		hosts = append(hosts, d.Host)
+	}
+	return hosts
+}
@@ -0,0 +1,110 @@
+package commonregex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDomain(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []struct {
+		host                                  string
+		subdomain, registrable, suffix, ascii string
+	}{
+		{"example.com", "", "example.com", "com", "example.com"},
+		{"www.Example.co.uk", "www", "Example.co.uk", "co.uk", "www.example.co.uk"},
+		{"a.b.example.org", "a.b", "example.org", "org", "a.b.example.org"},
+		{"foo.bar.ck", "", "foo.bar.ck", "bar.ck", "foo.bar.ck"},
+		{"www.ck", "", "www.ck", "ck", "www.ck"},
+		{"пример.рф", "", "пример.рф", "рф", "xn--e1afmkfd.xn--p1ai"},
+		{"xn--e1afmkfd.xn--p1ai", "", "xn--e1afmkfd.xn--p1ai", "xn--p1ai", "xn--e1afmkfd.xn--p1ai"},
+		{"shop.bücher.de", "shop", "bücher.de", "de", "shop.xn--bcher-kva.de"},
+	}
+
+	for _, test := range tests {
+		d, ok := ParseDomain(test.host)
+		assert.True(ok, test.host)
+		assert.Equal(Domain{
+			Host:        test.host,
+			Subdomain:   test.subdomain,
+			Registrable: test.registrable,
+			Suffix:      test.suffix,
+			ASCII:       test.ascii,
+		}, d)
+	}
+
+	failingTests := []string{
+		"com",
+		"co.uk",
+		"bar.ck",
+		"main.json",
+		"1.2.3.4",
+		"example..com",
+		"xn--99999999999.com",
+		"ex ample.com",
+		"-example.com",
+		"",
+	}
+
+	for _, host := range failingTests {
+		_, ok := ParseDomain(host)
+		assert.False(ok, host)
+	}
+}
+
+func TestFindDomains(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := "See https://www.example.co.uk/path, mail john@mail.example.org, " +
+		"edit main.go and config.json, read README.md, v1.2.3, visit пример.рф."
+	assert.Equal([]string{"www.example.co.uk", "mail.example.org", "README.md", "пример.рф"}, Domains(text))
+	assert.Equal([]string{"www.example.co.uk", "mail.example.org", "пример.рф"}, Domains(text, ExcludeFileExtensions()))
+
+	domains := FindDomains(text, ExcludeFileExtensions(), Limit(2))
+	assert.Len(domains, 2)
+	d := domains[1]
+	assert.Equal("mail.example.org", text[d.Start:d.End])
+	assert.Equal("mail", d.Subdomain)
+	assert.Equal("example.org", d.Registrable)
+
+	assert.Equal([]string{"example.com"}, Domains("example.com EXAMPLE.com example.com", Unique(), CaseFold()))
+	assert.Nil(Domains("foo_bar.example.com and my-host.example"))
+
+	text = "evil[.]example[.]com"
+	domains = FindDomains(text, Deobfuscated())
+	assert.Len(domains, 1)
+	assert.Equal("evil.example.com", domains[0].Host)
+	assert.Equal(text, text[domains[0].Start:domains[0].End])
+}
+
+func TestPunycode(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []struct {
+		unicode, encoded string
+	}{
+		{"bücher", "bcher-kva"},
+		{"пример", "e1afmkfd"},
+		{"例え", "r8jz45g"},
+		{"münchen", "mnchen-3ya"},
+		{"ü", "tda"},
+		{"abc", "abc-"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.encoded, punycodeEncode(test.unicode))
+		decoded, err := punycodeDecode(test.encoded)
+		assert.NoError(err)
+		assert.Equal(test.unicode, decoded)
+	}
+
+	for _, encoded := range []string{"a-!", "ü-abc", "zzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzz", "99999999999"} {
+		_, err := punycodeDecode(encoded)
+		assert.Error(err, encoded)
+	}
+}
@@ -0,0 +1,112 @@
+package commonregex
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// driversLicenseFormats are the formats of the driver's licence numbers each
+// US state and DC issues, by postal code. DriversLicensePattern matches the
+// union of those mixing letters and digits, and numbers of digits only, which
+// look like any other number and so only count next to the postal code of a
+// state whose format they fit. Numbers of letters only, which New York once
+// issued, are not found.
+var driversLicenseFormats = map[string]string{
+	"AL": `\d{7,8}`,
+	"AK": `\d{1,7}`,
+	"AZ": `[A-Z]\d{8}|\d{9}`,
+	"AR": `\d{4,9}`,
+	"CA": `[A-Z]\d{7}`,
+	"CO": `\d{9}|[A-Z]\d{3,6}|[A-Z]{2}\d{2,5}`,
+	"CT": `\d{9}`,
+	"DE": `\d{1,7}`,
+	"DC": `\d{7}|\d{9}`,
+	"FL": `[A-Z]\d{12}`,
+	"GA": `\d{7,9}`,
+	"HI": `H\d{8}|\d{9}`,
+	"ID": `[A-Z]{2}\d{6}[A-Z]|\d{9}`,
+	"IL": `[A-Z]\d{11,12}`,
+	"IN": `[A-Z]\d{9}|\d{9,10}`,
+	"IA": `\d{9}|\d{3}[A-Z]{2}\d{4}`,
+	"KS": `[A-Z]\d[A-Z]\d[A-Z]|K\d{8}|\d{9}`,
+	"KY": `[A-Z]\d{8,9}|\d{9}`,
+	"LA": `\d{1,9}`,
+	"ME": `\d{7,8}|\d{7}[A-Z]`,
+	"MD": `[A-Z]\d{12}`,
+	"MA": `[A-Z]\d{8}|\d{9}`,
+	"MI": `[A-Z]\d{10}|[A-Z]\d{12}`,
+	"MN": `[A-Z]\d{12}`,
+	"MS": `\d{9}`,
+	"MO": `[A-Z]\d{5,10}|[A-Z]\d{6}R|\d{8}[A-Z]{2}|\d{9}[A-Z]?`,
+	"MT": `[A-Z]\d{8}|\d{9}|\d{13,14}`,
+	"NE": `[A-Z]\d{6,8}`,
+	"NV": `\d{9,10}|\d{12}|X\d{8}`,
+	"NH": `\d{2}[A-Z]{3}\d{5}`,
+	"NJ": `[A-Z]\d{14}`,
+	"NM": `\d{8,9}`,
+	"NY": `[A-Z]\d{7}|[A-Z]\d{18}|\d{8,9}|\d{16}|[A-Z]{8}`,
+	"NC": `\d{1,12}`,
+	"ND": `[A-Z]{3}\d{6}|\d{9}`,
+	"OH": `[A-Z]\d{4,8}|[A-Z]{2}\d{3,7}|\d{8}`,
+	"OK": `[A-Z]\d{9}|\d{9}`,
+	"OR": `\d{1,9}|[A-Z]\d{6}|[A-Z]{2}\d{5}`,
+	"PA": `\d{8}`,
+	"RI": `\d{7}|[A-Z]\d{6}`,
+	"SC": `\d{5,11}`,
+	"SD": `\d{6,10}|\d{12}`,
+	"TN": `\d{7,9}`,
+	"TX": `\d{7,8}`,
+	"UT": `\d{4,10}`,
+	"VT": `\d{8}|\d{7}A`,
+	"VA": `[A-Z]\d{8,11}|\d{9}`,
+	"WA": `WDL[A-Z\d]{9}|[A-Z][A-Z*]{6}[A-Z\d*]{4}[A-Z\d]`,
+	"WV": `\d{7}|[A-Z]{1,2}\d{5,6}`,
+	"WI": `[A-Z]\d{13}`,
+	"WY": `\d{9,10}`,
+}
+
+// driversLicenseRegexes holds the anchored formats of driversLicenseFormats
+var driversLicenseRegexes = func() map[string]*regexp.Regexp {
+	regexes := make(map[string]*regexp.Regexp, len(driversLicenseFormats))
+	for state, format := range driversLicenseFormats {
+		regexes[state] = regexp.MustCompile(`^(?:` + format + `)$`)
+	}
+	return regexes
+}()
+
+// DriversLicenseStates returns the postal codes of the US states, and DC,
+// whose driver's licence format number has, in alphabetical order. Formats
+// overlap a lot, so a number often fits several states.
+func DriversLicenseStates(number string) []string {
+	var states []string
+	for state, regex := range driversLicenseRegexes {
+		if regex.MatchString(number) {
+			states = append(states, state)
+		}
+	}
+	sort.Strings(states)
+	return states
+}
+
+// stateCodeRegex matches what may be the postal code of a state
+var stateCodeRegex = regexp.MustCompile(`\b[A-Z]{2}\b`)
+
+// stateHinted reports whether the driver's licence number text[start:end]
+// mixes letters and digits, or is all digits and has the postal code of a
+// state whose format it fits within contextWindow bytes of it
+func stateHinted(text string, start, end int) bool {
+	number := text[start:end]
+	if !strings.ContainsAny(number, "0123456789") {
+		return false
+	}
+	if !isDigits(number) {
+		return true
+	}
+	for _, state := range stateCodeRegex.FindAllString(around(text, start, end), -1) {
+		if regex, ok := driversLicenseRegexes[state]; ok && regex.MatchString(number) {
+			return true
+		}
+	}
+	return false
+}
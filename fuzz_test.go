@@ -0,0 +1,613 @@
+package commonregex
+
+import (
+	"math/rand"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mingrammer/commonregex/generator"
+	"github.com/stretchr/testify/assert"
+)
+
+type finder struct {
+	name     string
+	find     func(string, ...Option) []string
+	regex    *regexp.Regexp
+	generate func(*rand.Rand) string
+}
+
+// withKeyword prefixes the values of a generator with the keyword a
+// context-gated kind needs nearby
+func withKeyword(keyword string, generate func(*rand.Rand) string) func(*rand.Rand) string {
+	return func(r *rand.Rand) string {
+		return keyword + " " + generate(r)
+	}
+}
+
+// gateLocs keeps the offsets of matches of a context-gated or checked kind
+// which pass its gate, and returns the offsets of any other kind as they are
+func gateLocs(regex *regexp.Regexp, text string, locs [][]int) [][]int {
+	for _, info := range builtinKinds {
+		if info.regex == regex && info.gated() {
+			return info.gate(text, locs, -1)
+		}
+	}
+	return locs
+}
+
+var finders = []finder{
+	{"Date", Date, DateRegex, generator.Date},
+	{"Time", Time, TimeRegex, generator.Time},
+	{"Timestamps", Timestamps, TimestampRegex, generator.Timestamp},
+	{"EpochTimes", EpochTimes, EpochTimeRegex, withKeyword("timestamp", generator.EpochTime)},
+	{"Phones", Phones, PhoneRegex, generator.Phone},
+	{"PhonesWithExts", PhonesWithExts, PhonesWithExtsRegex, generator.PhoneWithExt},
+	{"Links", Links, LinkRegex, generator.Link},
+	{"Emails", Emails, EmailRegex, generator.Email},
+	{"IPv4s", IPv4s, IPv4Regex, generator.IPv4},
+	{"IPv6s", IPv6s, IPv6Regex, generator.IPv6},
+	{"IPs", IPs, IPRegex, generator.IP},
+	{"NotKnownPorts", NotKnownPorts, NotKnownPortRegex, generator.NotKnownPort},
+	{"Prices", Prices, PriceRegex, generator.Price},
+	{"HexColors", HexColors, HexColorRegex, generator.HexColor},
+	{"CreditCards", CreditCards, CreditCardRegex, generator.CreditCard},
+	{"BtcAddresses", BtcAddresses, BtcAddressRegex, generator.BtcAddress},
+	{"EthAddresses", EthAddresses, EthAddressRegex, generator.EthAddress},
+	{"LtcAddresses", LtcAddresses, LtcAddressRegex, generator.LtcAddress},
+	{"BchAddresses", BchAddresses, BchAddressRegex, generator.BchAddress},
+	{"DogeAddresses", DogeAddresses, DogeAddressRegex, generator.DogeAddress},
+	{"XmrAddresses", XmrAddresses, XmrAddressRegex, generator.XmrAddress},
+	{"SolAddresses", SolAddresses, SolAddressRegex, withKeyword("solana", generator.SolAddress)},
+	{"TrxAddresses", TrxAddresses, TrxAddressRegex, generator.TrxAddress},
+	{"XrpAddresses", XrpAddresses, XrpAddressRegex, generator.XrpAddress},
+	{"StreetAddresses", StreetAddresses, StreetAddressRegex, generator.StreetAddress},
+	{"ZipCodes", ZipCodes, ZipCodeRegex, generator.ZipCode},
+	{"PoBoxes", PoBoxes, PoBoxRegex, generator.PoBox},
+	{"SSNs", SSNs, SSNRegex, generator.SSN},
+	{"NINOs", NINOs, NINORegex, generator.NINO},
+	{"SINs", SINs, SINRegex, generator.SIN},
+	{"NIRs", NIRs, NIRRegex, generator.NIR},
+	{"SteuerIDs", SteuerIDs, SteuerIDRegex, generator.SteuerID},
+	{"FiscalCodes", FiscalCodes, FiscalCodeRegex, generator.FiscalCode},
+	{"DNIs", DNIs, DNIRegex, generator.DNI},
+	{"NIEs", NIEs, NIERegex, generator.NIE},
+	{"BSNs", BSNs, BSNRegex, generator.BSN},
+	{"Aadhaars", Aadhaars, AadhaarRegex, generator.Aadhaar},
+	{"PANs", PANs, PANRegex, generator.PAN},
+	{"CPFs", CPFs, CPFRegex, generator.CPF},
+	{"CNPJs", CNPJs, CNPJRegex, generator.CNPJ},
+	{"RRNs", RRNs, RRNRegex, generator.RRN},
+	{"Passports", Passports, PassportRegex, withKeyword("passport", generator.Passport)},
+	{"DriversLicenses", DriversLicenses, DriversLicenseRegex, withKeyword("driver's license", generator.DriversLicense)},
+	{"EINs", EINs, EINRegex, withKeyword("EIN", generator.EIN)},
+	{"ITINs", ITINs, ITINRegex, withKeyword("ITIN", generator.ITIN)},
+	{"MD5Hexes", MD5Hexes, MD5HexRegex, generator.MD5Hex},
+	{"SHA1Hexes", SHA1Hexes, SHA1HexRegex, generator.SHA1Hex},
+	{"SHA256Hexes", SHA256Hexes, SHA256HexRegex, generator.SHA256Hex},
+	{"GUIDs", GUIDs, GUIDRegex, generator.GUID},
+	{"ISBN13s", ISBN13s, ISBN13Regex, generator.ISBN13},
+	{"ISBN10s", ISBN10s, ISBN10Regex, generator.ISBN10},
+	{"VISACreditCards", VISACreditCards, VISACreditCardRegex, generator.VISACreditCard},
+	{"MCCreditCards", MCCreditCards, MCCreditCardRegex, generator.MCCreditCard},
+	{"MACAddresses", MACAddresses, MACAddressRegex, generator.MACAddress},
+	{"IBANs", IBANs, IBANRegex, generator.IBAN},
+	{"GitRepos", GitRepos, GitRepoRegex, generator.GitRepo},
+}
+
+// negativeCorpus holds strings each finder must not match anything in. Add
+// an entry whenever a false positive is fixed so it cannot come back.
+var negativeCorpus = map[string][]string{
+	"Emails": {
+		"not an email @ all",
+		"john.smith@",
+		"@gmail.com",
+	},
+	"IPv4s": {
+		"999.1.1.1",
+		"1.2.3",
+		"192.168.1.256",
+	},
+	"SSNs": {
+		"1234-56-78901",
+		"123-456-7890",
+		"123 45 6789",
+	},
+	"MD5Hexes": {
+		strings.Repeat("a", 31),
+		strings.Repeat("a", 33),
+		strings.Repeat("a", 40),
+		strings.Repeat("a", 64),
+		"b5ab01fad5a008d436f76aafc896f9c6g",
+	},
+	"SHA1Hexes": {
+		strings.Repeat("b", 39),
+		strings.Repeat("b", 64),
+		"da39a3ee5e6b4b0d3255bfef95601890afd80709da39a3ee5e6b4b0d3255bfef",
+	},
+	"SHA256Hexes": {
+		strings.Repeat("c", 63),
+		strings.Repeat("c", 128),
+	},
+	"GUIDs": {
+		strings.Repeat("d", 40),
+		"88a310ed-0ac0-4a3d-b3a2-958fa291d0611",
+	},
+	"MACAddresses": {
+		"aa:bb:cc:dd:ee",
+		"3D:F2:C9:A6:B3:4G",
+	},
+	"HexColors": {
+		"#zzz",
+		"#12",
+	},
+	"Prices": {
+		"100 dollars",
+		"$",
+		"₹1,00,00",
+		"$1,10,0",
+		"100 EURO",
+	},
+	"GitRepos": {
+		"https://github.com/mingrammer/commonregex",
+		"test@github.com:mingrammer/commonregex",
+	},
+	"PoBoxes": {
+		"inbox 12",
+		"PO Box",
+	},
+	"BtcAddresses": {
+		"0x52908400098527886E0F7030069857D2E4169EE7",
+		"1Bow5EMqtDGV5n5xZVgdpR",
+	},
+	"EthAddresses": {
+		"0x52908400098527886E0F7030069857D2E4169E",
+		"0x52908400098527886E0F7030069857D2E4169EE7a",
+	},
+	"BchAddresses": {
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6",
+		"qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6ab",
+	},
+	"TrxAddresses": {
+		"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6",
+		"T0000000000000000000000000000000000",
+	},
+	"ZipCodes": {
+		"123456",
+		"1234",
+	},
+	"Links": {
+		"hello world",
+		"...",
+	},
+	"Timestamps": {
+		"2017-13-23T09:45:00Z",
+		"2017-03-23T24:00:00Z",
+		"Mar 32 09:45:00",
+		"[23/Mar/2017:09:45:00]",
+	},
+	"DriversLicenses": {
+		"Driver's license renewed in 2017, see PASSPORT OFFICE",
+		"License #: 123456789",
+	},
+	"EpochTimes": {
+		"call 1490262300",
+		"timestamp 2490262300",
+		"timestamp 149026230",
+	},
+	"SolAddresses": {
+		"sha256 7tUAv95W9QwyzY6NmdfnJoqfEi1YHBoNCh5qffrKorpm",
+		"solana So1111111111111111111111111111111111111111211",
+	},
+}
+
+// checkFinder asserts the invariants every finder must hold on any input
+func checkFinder(t *testing.T, f finder, text string) {
+	matches := f.find(text)
+	locs := gateLocs(f.regex, text, f.regex.FindAllStringIndex(text, -1))
+	if len(matches) != len(locs) {
+		t.Fatalf("%s: found %d matches but %d offsets in %q", f.name, len(matches), len(locs), text)
+	}
+	end := 0
+	for i, loc := range locs {
+		if loc[0] < end || loc[0] > loc[1] || loc[1] > len(text) {
+			t.Fatalf("%s: offsets %v are out of order in %q", f.name, locs, text)
+		}
+		if text[loc[0]:loc[1]] != matches[i] {
+			t.Fatalf("%s: match %q is not the substring at %v of %q", f.name, matches[i], loc, text)
+		}
+		end = loc[1]
+	}
+}
+
+func fuzzFinder(f *testing.F, name string) {
+	var fd finder
+	for _, candidate := range finders {
+		if candidate.name == name {
+			fd = candidate
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		value := fd.generate(r)
+		f.Add(value)
+		f.Add("prefix " + value + " suffix")
+		f.Add(value + value)
+		f.Add("first line\n" + value + "\nlast line")
+	}
+	for _, text := range negativeCorpus[name] {
+		f.Add(text)
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		checkFinder(t, fd, text)
+	})
+}
+
+func FuzzDate(f *testing.F)            { fuzzFinder(f, "Date") }
+func FuzzTime(f *testing.F)            { fuzzFinder(f, "Time") }
+func FuzzTimestamps(f *testing.F)      { fuzzFinder(f, "Timestamps") }
+func FuzzEpochTimes(f *testing.F)      { fuzzFinder(f, "EpochTimes") }
+func FuzzPhones(f *testing.F)          { fuzzFinder(f, "Phones") }
+func FuzzPhonesWithExts(f *testing.F)  { fuzzFinder(f, "PhonesWithExts") }
+func FuzzLinks(f *testing.F)           { fuzzFinder(f, "Links") }
+func FuzzEmails(f *testing.F)          { fuzzFinder(f, "Emails") }
+func FuzzIPv4s(f *testing.F)           { fuzzFinder(f, "IPv4s") }
+func FuzzIPv6s(f *testing.F)           { fuzzFinder(f, "IPv6s") }
+func FuzzIPs(f *testing.F)             { fuzzFinder(f, "IPs") }
+func FuzzNotKnownPorts(f *testing.F)   { fuzzFinder(f, "NotKnownPorts") }
+func FuzzPrices(f *testing.F)          { fuzzFinder(f, "Prices") }
+func FuzzHexColors(f *testing.F)       { fuzzFinder(f, "HexColors") }
+func FuzzCreditCards(f *testing.F)     { fuzzFinder(f, "CreditCards") }
+func FuzzBtcAddresses(f *testing.F)    { fuzzFinder(f, "BtcAddresses") }
+func FuzzEthAddresses(f *testing.F)    { fuzzFinder(f, "EthAddresses") }
+func FuzzLtcAddresses(f *testing.F)    { fuzzFinder(f, "LtcAddresses") }
+func FuzzBchAddresses(f *testing.F)    { fuzzFinder(f, "BchAddresses") }
+func FuzzDogeAddresses(f *testing.F)   { fuzzFinder(f, "DogeAddresses") }
+func FuzzXmrAddresses(f *testing.F)    { fuzzFinder(f, "XmrAddresses") }
+func FuzzSolAddresses(f *testing.F)    { fuzzFinder(f, "SolAddresses") }
+func FuzzTrxAddresses(f *testing.F)    { fuzzFinder(f, "TrxAddresses") }
+func FuzzXrpAddresses(f *testing.F)    { fuzzFinder(f, "XrpAddresses") }
+func FuzzStreetAddresses(f *testing.F) { fuzzFinder(f, "StreetAddresses") }
+func FuzzZipCodes(f *testing.F)        { fuzzFinder(f, "ZipCodes") }
+func FuzzPoBoxes(f *testing.F)         { fuzzFinder(f, "PoBoxes") }
+func FuzzSSNs(f *testing.F)            { fuzzFinder(f, "SSNs") }
+func FuzzNINOs(f *testing.F)           { fuzzFinder(f, "NINOs") }
+func FuzzSINs(f *testing.F)            { fuzzFinder(f, "SINs") }
+func FuzzNIRs(f *testing.F)            { fuzzFinder(f, "NIRs") }
+func FuzzSteuerIDs(f *testing.F)       { fuzzFinder(f, "SteuerIDs") }
+func FuzzFiscalCodes(f *testing.F)     { fuzzFinder(f, "FiscalCodes") }
+func FuzzDNIs(f *testing.F)            { fuzzFinder(f, "DNIs") }
+func FuzzNIEs(f *testing.F)            { fuzzFinder(f, "NIEs") }
+func FuzzBSNs(f *testing.F)            { fuzzFinder(f, "BSNs") }
+func FuzzAadhaars(f *testing.F)        { fuzzFinder(f, "Aadhaars") }
+func FuzzPANs(f *testing.F)            { fuzzFinder(f, "PANs") }
+func FuzzCPFs(f *testing.F)            { fuzzFinder(f, "CPFs") }
+func FuzzCNPJs(f *testing.F)           { fuzzFinder(f, "CNPJs") }
+func FuzzRRNs(f *testing.F)            { fuzzFinder(f, "RRNs") }
+func FuzzPassports(f *testing.F)       { fuzzFinder(f, "Passports") }
+func FuzzDriversLicenses(f *testing.F) { fuzzFinder(f, "DriversLicenses") }
+func FuzzEINs(f *testing.F)            { fuzzFinder(f, "EINs") }
+func FuzzITINs(f *testing.F)           { fuzzFinder(f, "ITINs") }
+func FuzzMD5Hexes(f *testing.F)        { fuzzFinder(f, "MD5Hexes") }
+func FuzzSHA1Hexes(f *testing.F)       { fuzzFinder(f, "SHA1Hexes") }
+func FuzzSHA256Hexes(f *testing.F)     { fuzzFinder(f, "SHA256Hexes") }
+func FuzzGUIDs(f *testing.F)           { fuzzFinder(f, "GUIDs") }
+func FuzzISBN13s(f *testing.F)         { fuzzFinder(f, "ISBN13s") }
+func FuzzISBN10s(f *testing.F)         { fuzzFinder(f, "ISBN10s") }
+func FuzzVISACreditCards(f *testing.F) { fuzzFinder(f, "VISACreditCards") }
+func FuzzMCCreditCards(f *testing.F)   { fuzzFinder(f, "MCCreditCards") }
+func FuzzMACAddresses(f *testing.F)    { fuzzFinder(f, "MACAddresses") }
+func FuzzIBANs(f *testing.F)           { fuzzFinder(f, "IBANs") }
+func FuzzGitRepos(f *testing.F)        { fuzzFinder(f, "GitRepos") }
+
+// FuzzDomains checks that every domain found is the host at its offsets and
+// splits into its parts
+func FuzzDomains(f *testing.F) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		value := generator.Domain(r)
+		f.Add(value)
+		f.Add("prefix " + value + " suffix")
+		f.Add("https://" + value + "/a.b.c, v1.2.3 " + value + ".")
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		for _, d := range FindDomains(text) {
+			if text[d.Start:d.End] != d.Host {
+				t.Fatalf("domain %q is not the substring at %d-%d of %q", d.Host, d.Start, d.End, text)
+			}
+			if d.Subdomain != "" && d.Subdomain+"."+d.Registrable != d.Host ||
+				d.Subdomain == "" && d.Registrable != d.Host ||
+				!strings.HasSuffix(d.Registrable, "."+d.Suffix) {
+				t.Fatalf("domain %+v does not split into its parts", d)
+			}
+		}
+	})
+}
+
+// FuzzPostalCodes checks that every postal code found is the substring at its
+// offsets and that no code found lies inside another
+func FuzzPostalCodes(f *testing.F) {
+	f.Add("SW1A 1AA, M5V 3L9, 1012 AB, 100-0001, 01310-100, 110 001, D02 X285")
+	f.Add("90210-1234 1100-148 00-950 C1425DKA 10115")
+
+	f.Fuzz(func(t *testing.T, text string) {
+		end := 0
+		for _, code := range FindPostalCodes(text) {
+			if text[code.Start:code.End] != code.Value {
+				t.Fatalf("postal code %q is not the substring at %d-%d of %q", code.Value, code.Start, code.End, text)
+			}
+			if code.End <= end || len(code.Countries) == 0 {
+				t.Fatalf("postal code %+v is inside another or has no country in %q", code, text)
+			}
+			end = code.End
+		}
+	})
+}
+
+// FuzzAddresses checks that addresses are found in order, without
+// overlapping, and that each starts with its street line
+func FuzzAddresses(f *testing.F) {
+	f.Add("123 Main Street\nSuite 400\nSpringfield, IL 62704\nUSA\n\nPO Box 42, Anytown NY 12345-6789")
+	f.Add("10 Downing Street\nLondon\nSW1A 2AA\n\n42 Wellington St. W, Toronto, ON M5V 3L9, Canada")
+	f.Add("123 Main Street\n\nSpringfield, IL 62704")
+
+	f.Fuzz(func(t *testing.T, text string) {
+		end := 0
+		for _, a := range FindAddresses(text) {
+			if a.Start < end || a.End < a.Start || a.End > len(text) {
+				t.Fatalf("address %+v overlaps the one before or is out of bounds in %q", a, text)
+			}
+			if !strings.HasPrefix(text[a.Start:a.End], a.Street) || a.PostalCode == "" {
+				t.Fatalf("address %+v does not start with its street or has no postal code in %q", a, text)
+			}
+			end = a.End
+		}
+	})
+}
+
+// FuzzMoney checks that every amount found is the substring at its offsets
+// and reads as an exact decimal
+func FuzzMoney(f *testing.F) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		f.Add("total " + generator.Price(r) + ", " + generator.Price(r))
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		for _, m := range FindMoney(text) {
+			if text[m.Start:m.End] != m.Value {
+				t.Fatalf("amount %q is not the substring at %d-%d of %q", m.Value, m.Start, m.End, text)
+			}
+			if m.Currency == "" || m.Amount.Rat() == nil {
+				t.Fatalf("amount %+v has no currency or is not a decimal in %q", m, text)
+			}
+		}
+	})
+}
+
+// FuzzScan checks that Scan, which skips text through the prefilter, finds
+// exactly what running every regular expression over the whole text finds
+func FuzzScan(f *testing.F) {
+	r := rand.New(rand.NewSource(1))
+	for _, fd := range finders {
+		f.Add("some text " + fd.generate(r) + "\nmore text " + fd.generate(r))
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		var want []Match
+		for _, info := range builtinKinds {
+			for _, loc := range gateLocs(info.regex, text, info.regex.FindAllStringIndex(text, -1)) {
+				want = append(want, Match{Kind: info.kind, Value: text[loc[0]:loc[1]], Start: loc[0], End: loc[1]})
+			}
+		}
+		sortMatches(want)
+		got := Scan(text)
+		if len(got) != len(want) {
+			t.Fatalf("Scan found %d matches, want %d in %q", len(got), len(want), text)
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("Scan found %+v, want %+v in %q", got[i], want[i], text)
+			}
+		}
+	})
+}
+
+// mutateDigit replaces one digit of s with a different digit
+func mutateDigit(r *rand.Rand, s string) string {
+	var positions []int
+	for i := 0; i < len(s); i++ {
+		if s[i] >= '0' && s[i] <= '9' {
+			positions = append(positions, i)
+		}
+	}
+	i := positions[r.Intn(len(positions))]
+	d := (int(s[i]-'0') + r.Intn(9) + 1) % 10
+	return s[:i] + string(rune('0'+d)) + s[i+1:]
+}
+
+// FuzzValidators checks that the validators accept every generated value and
+// reject it once a single digit is changed, which all of the check digit
+// schemes involved are guaranteed to detect.
+func FuzzValidators(f *testing.F) {
+	for seed := int64(0); seed < 10; seed++ {
+		f.Add(seed)
+	}
+
+	validators := []struct {
+		name     string
+		valid    func(string) bool
+		generate func(*rand.Rand) string
+		mutable  bool
+	}{
+		{"CreditCard", ValidCreditCard, generator.CreditCard, true},
+		{"VISACreditCard", ValidCreditCard, generator.VISACreditCard, true},
+		{"MCCreditCard", ValidCreditCard, generator.MCCreditCard, true},
+		{"IBAN", ValidIBAN, generator.IBAN, true},
+		{"ISBN13", ValidISBN13, generator.ISBN13, true},
+		{"ISBN10", ValidISBN10, generator.ISBN10, true},
+		{"SSN", ValidSSN, generator.SSN, false},
+		{"NINO", ValidNINO, generator.NINO, false},
+		{"SIN", ValidSIN, generator.SIN, true},
+		{"NIR", ValidNIR, generator.NIR, true},
+		{"SteuerID", ValidSteuerID, generator.SteuerID, true},
+		{"FiscalCode", ValidFiscalCode, generator.FiscalCode, true},
+		{"DNI", ValidDNI, generator.DNI, true},
+		{"NIE", ValidNIE, generator.NIE, true},
+		{"BSN", ValidBSN, generator.BSN, true},
+		{"Aadhaar", ValidAadhaar, generator.Aadhaar, true},
+		{"PAN", ValidPAN, generator.PAN, false},
+		{"CPF", ValidCPF, generator.CPF, false},
+		{"CNPJ", ValidCNPJ, generator.CNPJ, false},
+		{"RRN", ValidRRN, generator.RRN, false},
+		{"EIN", ValidEIN, generator.EIN, false},
+		{"ITIN", ValidITIN, generator.ITIN, false},
+		{"BtcAddress", ValidBtcAddress, generator.BtcAddress, false},
+		{"EthAddress", ValidEthAddress, generator.EthAddress, false},
+		{"LtcAddress", ValidLtcAddress, generator.LtcAddress, false},
+		{"BchAddress", ValidBchAddress, generator.BchAddress, false},
+		{"DogeAddress", ValidDogeAddress, generator.DogeAddress, false},
+		{"XmrAddress", ValidXmrAddress, generator.XmrAddress, false},
+		{"SolAddress", ValidSolAddress, generator.SolAddress, false},
+		{"TrxAddress", ValidTrxAddress, generator.TrxAddress, false},
+		{"XrpAddress", ValidXrpAddress, generator.XrpAddress, false},
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		for _, v := range validators {
+			value := v.generate(r)
+			if !v.valid(value) {
+				t.Fatalf("%s: generated value %q is not valid", v.name, value)
+			}
+			if !v.mutable {
+				continue
+			}
+			mutated := mutateDigit(r, value)
+			if v.valid(mutated) {
+				t.Fatalf("%s: %q is valid after mutating %q", v.name, mutated, value)
+			}
+		}
+	})
+}
+
+func TestFuzz_NegativeCorpus(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	for _, f := range finders {
+		for _, text := range negativeCorpus[f.name] {
+			assert.Empty(f.find(text), "%s should not match anything in %q", f.name, text)
+		}
+	}
+}
+
+func TestFuzz_GeneratedValues(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	for _, f := range finders {
+		for i := 0; i < 100; i++ {
+			value := f.generate(r)
+			checkFinder(t, f, value)
+			checkFinder(t, f, "lorem "+value+", ipsum "+value+".")
+			checkFinder(t, f, "lorem\n"+value+"\n\nipsum "+value+"\n")
+		}
+	}
+}
+
+// FuzzQuantities checks that every number, percentage and quantity found is
+// the substring at its offsets and reads as an exact decimal
+func FuzzQuantities(f *testing.F) {
+	f.Add("weighs 3.5 kg, rose 15%, 1.2e-3 and 120 km/h")
+	f.Add("1.234,5 kg und 3,5 %")
+	f.Add("v1.2.3 at 10.0.0.1 on 2017-03-23, 2 TB")
+
+	f.Fuzz(func(t *testing.T, text string) {
+		for _, locale := range []Locale{LocaleEnglish, LocaleFrench, LocaleGerman} {
+			for _, n := range FindNumbers(text, InLocale(locale)) {
+				if text[n.Start:n.End] != n.Value || n.Decimal.Rat() == nil {
+					t.Fatalf("number %+v is not the substring at its offsets or not a decimal in %q", n, text)
+				}
+			}
+			for _, p := range FindPercentages(text, InLocale(locale)) {
+				if text[p.Start:p.End] != p.Value || p.Percent.Rat() == nil {
+					t.Fatalf("percentage %+v is not the substring at its offsets or not a decimal in %q", p, text)
+				}
+			}
+			for _, q := range FindQuantities(text, InLocale(locale)) {
+				if text[q.Start:q.End] != q.Value || q.Amount.Rat() == nil || q.Unit == "" {
+					t.Fatalf("quantity %+v is not the substring at its offsets or has no unit in %q", q, text)
+				}
+			}
+		}
+	})
+}
+
+// FuzzRelativeTimes checks that every relative time found is the substring
+// at its offsets and as far from the reference as its duration says
+func FuzzRelativeTimes(f *testing.F) {
+	f.Add("deploy in 2 hours, rolled back 3 days ago, timeout 1h30m")
+	f.Add("retry PT15M or P1Y2M10DT2H30M, meet next Tuesday")
+	f.Add("the day after tomorrow, 2 hours and 30 minutes later, last week")
+
+	ref := time.Date(2026, 10, 21, 15, 4, 0, 0, time.UTC)
+	f.Fuzz(func(t *testing.T, text string) {
+		for _, r := range FindRelativeTimes(text, RelativeTo(ref)) {
+			if text[r.Start:r.End] != r.Value {
+				t.Fatalf("relative time %q is not the substring at %d-%d of %q", r.Value, r.Start, r.End, text)
+			}
+			if !ref.Add(r.Duration).Equal(r.Time) {
+				t.Fatalf("relative time %+v is not its duration from the reference in %q", r, text)
+			}
+		}
+	})
+}
+
+// FuzzFindTimestamps checks that every timestamp found is the substring at
+// its offsets and parses on its own to the same time
+func FuzzFindTimestamps(f *testing.F) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		f.Add(generator.Timestamp(r) + " host: timestamp " + generator.EpochTime(r))
+	}
+
+	ref := time.Date(2026, 10, 21, 15, 4, 0, 0, time.UTC)
+	f.Fuzz(func(t *testing.T, text string) {
+		for _, ts := range FindTimestamps(text, RelativeTo(ref)) {
+			if text[ts.Start:ts.End] != ts.Value {
+				t.Fatalf("timestamp %q is not the substring at %d-%d of %q", ts.Value, ts.Start, ts.End, text)
+			}
+			if parsed, ok := parseTimestamp(ts.Value, ref); !ok || !parsed.Equal(ts.Time) {
+				t.Fatalf("timestamp %+v does not parse on its own in %q", ts, text)
+			}
+		}
+	})
+}
+
+// FuzzParseDates checks that every date found in a locale is the substring at
+// its offsets and is a day that exists
+func FuzzParseDates(f *testing.F) {
+	f.Add("Jan 9th 2012, 3-23-17 and 2017-03-23")
+	f.Add("jeudi 23 mars 2017, le 1er avril et le 23/03/2017")
+	f.Add("Donnerstag, 23. März 2017 und 24.03.17")
+	f.Add("2017년 3월 23일 (목), 2017年3月23日(木)")
+
+	locales := []Locale{LocaleEnglish, LocaleFrench, LocaleGerman, LocaleSpanish, LocaleKorean, LocaleJapanese}
+	f.Fuzz(func(t *testing.T, text string) {
+		for _, locale := range locales {
+			for _, d := range ParseDates(text, InLocale(locale)) {
+				if text[d.Start:d.End] != d.Value {
+					t.Fatalf("date %q is not the substring at %d-%d of %q", d.Value, d.Start, d.End, text)
+				}
+				if d.Month < time.January || d.Month > time.December || d.Day < 1 || d.Day > 31 {
+					t.Fatalf("date %+v in %s is not a day in %q", d, locale, text)
+				}
+			}
+		}
+	})
+}
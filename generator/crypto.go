@@ -0,0 +1,231 @@
+package generator
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"math/rand"
+	"strings"
+
+	"github.com/mingrammer/commonregex/internal/keccak"
+)
+
+const (
+	rippleAlphabet = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+	bech32Charset  = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+)
+
+// EthAddress generates an Ethereum address with an EIP-55 mixed-case
+// checksum
+func EthAddress(r *rand.Rand) string {
+	lower := hex.EncodeToString(randomBytes(r, 20))
+	hash := keccak.Sum256([]byte(lower))
+	out := []byte(lower)
+	for i, c := range out {
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+		if c >= 'a' && nibble >= 8 {
+			out[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(out)
+}
+
+// LtcAddress generates a Litecoin address: a base58check P2PKH ("L...") or
+// P2SH ("M...") address, or a bech32 segwit address ("ltc1...")
+func LtcAddress(r *rand.Rand) string {
+	switch r.Intn(3) {
+	case 0:
+		return base58CheckEncode(0x30, randomBytes(r, 20))
+	case 1:
+		return base58CheckEncode(0x32, randomBytes(r, 20))
+	}
+	program := randomBytes(r, 20)
+	if r.Intn(2) == 0 {
+		program = randomBytes(r, 32)
+	}
+	return segwitEncode("ltc", program)
+}
+
+// BchAddress generates a Bitcoin Cash CashAddr P2PKH ("q...") or P2SH
+// ("p...") address, with or without its "bitcoincash:" prefix
+func BchAddress(r *rand.Rand) string {
+	const prefix = "bitcoincash"
+	version := byte(0x00)
+	if r.Intn(2) == 0 {
+		version = 0x08
+	}
+	address := cashAddrEncode(prefix, version, randomBytes(r, 20))
+	if r.Intn(2) == 0 {
+		address = prefix + ":" + address
+	}
+	return address
+}
+
+// DogeAddress generates a base58check-valid Dogecoin P2PKH address ("D...")
+func DogeAddress(r *rand.Rand) string {
+	return base58CheckEncode(0x1e, randomBytes(r, 20))
+}
+
+// XmrAddress generates a Monero mainnet standard address ("4..."),
+// subaddress ("8...") or integrated address with a valid checksum
+func XmrAddress(r *rand.Rand) string {
+	data := []byte{0x12}
+	switch r.Intn(3) {
+	case 0:
+		data[0] = 0x2a
+	case 1:
+		data[0] = 0x13
+	}
+	data = append(data, randomBytes(r, 64)...)
+	if data[0] == 0x13 {
+		data = append(data, randomBytes(r, 8)...)
+	}
+	hash := keccak.Sum256(data)
+	return moneroBase58Encode(append(data, hash[:4]...))
+}
+
+// SolAddress generates a Solana address, the base58 encoding of 32 bytes.
+// SolAddressPattern only matches it with a keyword such as "solana" nearby.
+func SolAddress(r *rand.Rand) string {
+	return base58Encode(randomBytes(r, 32))
+}
+
+// TrxAddress generates a base58check-valid Tron address ("T...")
+func TrxAddress(r *rand.Rand) string {
+	return base58CheckEncode(0x41, randomBytes(r, 20))
+}
+
+// XrpAddress generates a base58check-valid XRP Ledger classic address
+// ("r...")
+func XrpAddress(r *rand.Rand) string {
+	address := []byte(base58CheckEncode(0x00, randomBytes(r, 20)))
+	for i, c := range address {
+		address[i] = rippleAlphabet[strings.IndexByte(base58Alphabet, c)]
+	}
+	return string(address)
+}
+
+// convertBits regroups 8-bit bytes into 5-bit values or the reverse, padding
+// the last group with zeros
+func convertBits(data []byte, from, to uint) []byte {
+	acc, bits := 0, uint(0)
+	var out []byte
+	for _, v := range data {
+		acc = acc<<from | int(v)
+		bits += from
+		for bits >= to {
+			bits -= to
+			out = append(out, byte(acc>>bits&(1<<to-1)))
+		}
+	}
+	if bits > 0 {
+		out = append(out, byte(acc<<(to-bits)&(1<<to-1)))
+	}
+	return out
+}
+
+func bech32String(values []byte) string {
+	out := make([]byte, len(values))
+	for i, v := range values {
+		out[i] = bech32Charset[v]
+	}
+	return string(out)
+}
+
+// segwitEncode encodes a witness version 0 program as a bech32 address
+func segwitEncode(hrp string, program []byte) string {
+	values := append([]byte{0}, convertBits(program, 8, 5)...)
+	generator := [5]int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := 1
+	step := func(v byte) {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ int(v)
+		for i, g := range generator {
+			if top>>uint(i)&1 == 1 {
+				chk ^= g
+			}
+		}
+	}
+	for i := 0; i < len(hrp); i++ {
+		step(hrp[i] >> 5)
+	}
+	step(0)
+	for i := 0; i < len(hrp); i++ {
+		step(hrp[i] & 31)
+	}
+	for _, v := range values {
+		step(v)
+	}
+	for i := 0; i < 6; i++ {
+		step(0)
+	}
+	chk ^= 1
+	for i := 0; i < 6; i++ {
+		values = append(values, byte(chk>>uint(5*(5-i))&31))
+	}
+	return hrp + "1" + bech32String(values)
+}
+
+// cashAddrEncode encodes a hash as a CashAddr address, without its prefix
+func cashAddrEncode(prefix string, version byte, hash []byte) string {
+	values := convertBits(append([]byte{version}, hash...), 8, 5)
+	checksum := cashAddrPolymod(prefix, append(values, make([]byte, 8)...))
+	for i := 0; i < 8; i++ {
+		values = append(values, byte(checksum>>uint(5*(7-i))&31))
+	}
+	return bech32String(values)
+}
+
+func cashAddrPolymod(prefix string, values []byte) uint64 {
+	generator := [5]uint64{0x98f2bc8e61, 0x79b76d99e2, 0xf33e5fb3c4, 0xae2eabe2a8, 0x1e4f43e470}
+	c := uint64(1)
+	step := func(v byte) {
+		top := c >> 35
+		c = (c&0x07ffffffff)<<5 ^ uint64(v)
+		for i, g := range generator {
+			if top>>uint(i)&1 == 1 {
+				c ^= g
+			}
+		}
+	}
+	for i := 0; i < len(prefix); i++ {
+		step(prefix[i] & 31)
+	}
+	step(0)
+	for _, v := range values {
+		step(v)
+	}
+	return c ^ 1
+}
+
+// moneroBlockLengths maps the number of bytes in a block of Monero's base58
+// to the length of its encoding
+var moneroBlockLengths = [9]int{0, 2, 3, 5, 6, 7, 9, 10, 11}
+
+// moneroBase58Encode encodes data in Monero's base58, which encodes every 8
+// bytes as a block of 11 characters
+func moneroBase58Encode(data []byte) string {
+	var out strings.Builder
+	radix := big.NewInt(58)
+	for len(data) > 0 {
+		n := 8
+		if len(data) < n {
+			n = len(data)
+		}
+		var block [8]byte
+		copy(block[8-n:], data[:n])
+		value := new(big.Int).SetUint64(binary.BigEndian.Uint64(block[:]))
+		encoded := make([]byte, moneroBlockLengths[n])
+		mod := new(big.Int)
+		for i := len(encoded) - 1; i >= 0; i-- {
+			value.DivMod(value, radix, mod)
+			encoded[i] = base58Alphabet[mod.Int64()]
+		}
+		out.Write(encoded)
+		data = data[n:]
+	}
+	return out.String()
+}
@@ -0,0 +1,59 @@
+package generator
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Passport generates a US passport number, either nine digits or a letter
+// and eight digits, such as "C03005988"
+func Passport(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return randomDigits(r, 9)
+	}
+	return randomString(r, upperLetters, 1) + randomDigits(r, 8)
+}
+
+// DriversLicense generates a driver's licence number in the format of one of
+// a few US states, such as "D1234567" for California
+func DriversLicense(r *rand.Rand) string {
+	switch r.Intn(5) {
+	case 0:
+		// California
+		return randomString(r, upperLetters, 1) + randomDigits(r, 7)
+	case 1:
+		// Florida
+		return randomString(r, upperLetters, 1) + randomDigits(r, 12)
+	case 2:
+		// Iowa
+		return randomDigits(r, 3) + randomString(r, upperLetters, 2) + randomDigits(r, 4)
+	case 3:
+		// New Hampshire
+		return randomDigits(r, 2) + randomString(r, upperLetters, 3) + randomDigits(r, 5)
+	default:
+		// Washington
+		return "WDL" + randomString(r, upperLetters+"0123456789", 8) + randomDigits(r, 1)
+	}
+}
+
+// einPrefixes are some of the prefixes the IRS assigns EINs under
+var einPrefixes = []string{"01", "10", "20", "27", "30", "45", "52", "68", "71", "80", "88", "91", "98"}
+
+// EIN generates a US employer identification number such as "12-3456789"
+func EIN(r *rand.Rand) string {
+	return pick(r, einPrefixes) + "-" + randomDigits(r, 7)
+}
+
+// ITIN generates a US individual taxpayer identification number such as
+// "912-70-1234"
+func ITIN(r *rand.Rand) string {
+	group := 50 + r.Intn(50)
+	for group >= 66 && group <= 69 || group == 89 || group == 93 {
+		group = 50 + r.Intn(50)
+	}
+	area, serial := randomDigits(r, 2), randomDigits(r, 4)
+	if r.Intn(2) == 0 {
+		return fmt.Sprintf("9%s%02d%s", area, group, serial)
+	}
+	return fmt.Sprintf("9%s-%02d-%s", area, group, serial)
+}
@@ -0,0 +1,691 @@
+// Package generator produces random sample values for the patterns of
+// commonregex. Every value is one the corresponding finder matches as a whole,
+// and checksummed kinds (credit cards, IBANs, ISBNs, cryptocurrency
+// addresses) carry valid check digits, so the values can be used as fixtures
+// for tests and load tests.
+package generator
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+var (
+	monthNames = []string{
+		"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December",
+	}
+	streetNames = []string{
+		"Main", "Oak", "Pine", "Maple", "Cedar", "Elm", "Washington", "Lake", "Hill", "Sunset",
+	}
+	streetSuffixes = []string{
+		"street", "st", "avenue", "ave", "road", "rd", "highway", "hwy", "square", "sq",
+		"trail", "trl", "drive", "dr", "court", "ct", "park", "parkway", "pkwy",
+		"circle", "cir", "boulevard", "blvd", "lane", "ln", "way", "place", "pl", "terrace", "loop",
+	}
+	domainNames = []string{
+		"example", "google", "github", "mingrammer", "golang", "linkedin", "wikipedia",
+	}
+	topLevelDomains = []string{
+		"com", "net", "org", "io", "dev", "co.uk",
+	}
+	internationalDomains = []string{
+		"bücher.de", "xn--bcher-kva.de", "пример.рф", "xn--e1afmkfd.xn--p1ai", "例え.jp", "müller.example.com",
+	}
+	gitHosts = []string{
+		"github.com", "gitlab.com", "bitbucket.org",
+	}
+)
+
+const (
+	lowerAlnum     = "abcdefghijklmnopqrstuvwxyz0123456789"
+	lowerLetters   = "abcdefghijklmnopqrstuvwxyz"
+	upperLetters   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	hexDigits      = "0123456789abcdef"
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+)
+
+func pick(r *rand.Rand, list []string) string {
+	return list[r.Intn(len(list))]
+}
+
+func randomString(r *rand.Rand, alphabet string, n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = alphabet[r.Intn(len(alphabet))]
+	}
+	return string(b)
+}
+
+func randomDigits(r *rand.Rand, n int) string {
+	return randomString(r, "0123456789", n)
+}
+
+func randomBytes(r *rand.Rand, n int) []byte {
+	b := make([]byte, n)
+	r.Read(b)
+	return b
+}
+
+func ordinalSuffix(day int) string {
+	switch {
+	case day >= 11 && day <= 13:
+		return "th"
+	case day%10 == 1:
+		return "st"
+	case day%10 == 2:
+		return "nd"
+	case day%10 == 3:
+		return "rd"
+	}
+	return "th"
+}
+
+// monthName returns the full or abbreviated name of month. DatePattern only
+// matches full names when more text is required after them, which is not the
+// case in the day first style, so abbreviations can be forced.
+func monthName(r *rand.Rand, month int, abbreviate bool) string {
+	name := monthNames[month-1]
+	if !abbreviate && r.Intn(2) == 0 || len(name) <= 3 {
+		return name
+	}
+	abbr := name[:3]
+	if r.Intn(2) == 0 {
+		abbr += "."
+	}
+	return abbr
+}
+
+// Date generates a date in one of the styles DatePattern supports: day first
+// ("23 Mar 2017", "23rd of March, 2017"), month first ("March 23rd, 2017",
+// "Mar. 23 2017") or numeric ("3-23-17", "03.23.2017", "3/23/17",
+// "2017-03-23").
+func Date(r *rand.Rand) string {
+	day := r.Intn(28) + 1
+	month := r.Intn(12) + 1
+	year := 1970 + r.Intn(60)
+
+	switch r.Intn(3) {
+	case 0:
+		dayPart := strconv.Itoa(day)
+		if r.Intn(2) == 0 {
+			dayPart += ordinalSuffix(day)
+		}
+		if r.Intn(2) == 0 {
+			dayPart += " of"
+		}
+		return fmt.Sprintf("%s %s %d", dayPart, monthName(r, month, true), year)
+	case 1:
+		dayPart := strconv.Itoa(day)
+		if r.Intn(2) == 0 {
+			dayPart += ordinalSuffix(day)
+		}
+		if r.Intn(2) == 0 {
+			dayPart += ","
+		}
+		return fmt.Sprintf("%s %s %d", monthName(r, month, false), dayPart, year)
+	}
+	sep := string("-./"[r.Intn(3)])
+	if r.Intn(4) == 0 {
+		return fmt.Sprintf("%d%s%02d%s%02d", year, sep, month, sep, day)
+	}
+	yearPart := strconv.Itoa(year)
+	if r.Intn(2) == 0 {
+		yearPart = fmt.Sprintf("%02d", year%100)
+	}
+	if r.Intn(2) == 0 {
+		return fmt.Sprintf("%02d%s%02d%s%s", month, sep, day, sep, yearPart)
+	}
+	return fmt.Sprintf("%d%s%d%s%s", month, sep, day, sep, yearPart)
+}
+
+// timeZones are zone suffixes TimePattern and TimestampPattern accept
+var timeZones = []string{"", "Z", "+01:00", "-0500", " +0530", " UTC", " GMT", " PST", " CEST", " JST"}
+
+// Time generates a clock time such as "09:45", "9:45 pm", "9:00 A.M.", "9am"
+// or "09:45:00.250 +0100"
+func Time(r *rand.Rand) string {
+	meridiems := []string{"am", "pm", "AM", "PM", "a.m.", "P.M."}
+	switch r.Intn(4) {
+	case 0:
+		return fmt.Sprintf("%02d:%02d", r.Intn(24), r.Intn(60))
+	case 1:
+		return fmt.Sprintf("%02d:%02d:%02d%s%s", r.Intn(24), r.Intn(60), r.Intn(60), fraction(r), pick(r, timeZones))
+	case 2:
+		sep := ""
+		if r.Intn(2) == 0 {
+			sep = " "
+		}
+		return fmt.Sprintf("%d:%02d%s%s", r.Intn(12)+1, r.Intn(60), sep, pick(r, meridiems))
+	}
+	return fmt.Sprintf("%d%s", r.Intn(9)+1, pick(r, meridiems))
+}
+
+// fraction returns an empty or a decimal fraction of a second, like ".250"
+func fraction(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return ""
+	}
+	return "." + randomDigits(r, 1+r.Intn(6))
+}
+
+// Timestamp generates a log timestamp in one of the styles TimestampPattern
+// supports: ISO 8601 ("2017-03-23T09:45:00Z", "2017-03-23 09:45:00.250
+// +0100"), syslog ("Mar 23 09:45:00", "Thu Mar  3 09:45:00 UTC 2017") or
+// Apache ("[23/Mar/2017:09:45:00 +0000]").
+func Timestamp(r *rand.Rand) string {
+	year := 1970 + r.Intn(60)
+	month := r.Intn(12) + 1
+	day := r.Intn(28) + 1
+	clock := fmt.Sprintf("%02d:%02d:%02d", r.Intn(24), r.Intn(60), r.Intn(60))
+	abbr := monthNames[month-1][:3]
+
+	switch r.Intn(3) {
+	case 0:
+		return fmt.Sprintf("%d-%02d-%02d%s%s%s%s", year, month, day, pick(r, []string{"T", " "}), clock, fraction(r), pick(r, timeZones))
+	case 1:
+		stamp := fmt.Sprintf("%s %2d %s", abbr, day, clock)
+		if r.Intn(2) == 0 {
+			stamp = pick(r, []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}) + " " + stamp
+		}
+		if r.Intn(2) == 0 {
+			stamp += fmt.Sprintf(" %s %d", pick(r, []string{"UTC", "PST", "CET"}), year)
+		}
+		return stamp
+	}
+	offset := fmt.Sprintf("%c%02d%02d", "+-"[r.Intn(2)], r.Intn(14), 15*r.Intn(4))
+	return fmt.Sprintf("[%02d/%s/%d:%s %s]", day, abbr, year, clock, offset)
+}
+
+// EpochTime generates a Unix time between 2001 and 2033 in seconds, seconds
+// with a fraction, or milliseconds. EpochTimePattern only matches it with a
+// keyword such as "timestamp" nearby.
+func EpochTime(r *rand.Rand) string {
+	seconds := "1" + randomDigits(r, 9)
+	switch r.Intn(3) {
+	case 0:
+		return seconds
+	case 1:
+		return seconds + "." + randomDigits(r, 1+r.Intn(6))
+	}
+	return seconds + randomDigits(r, 3)
+}
+
+// Phone generates a phone number such as "234-567-8900", "(234) 567-8900",
+// "+1 234 567 8900" or "+41 22 730 5989"
+func Phone(r *rand.Rand) string {
+	area := randomDigits(r, 3)
+	exchange := randomDigits(r, 3)
+	line := randomDigits(r, 4)
+	switch r.Intn(5) {
+	case 0:
+		return fmt.Sprintf("%s-%s-%s", area, exchange, line)
+	case 1:
+		return fmt.Sprintf("(%s) %s-%s", area, exchange, line)
+	case 2:
+		return fmt.Sprintf("+1 %s %s %s", area, exchange, line)
+	case 3:
+		return fmt.Sprintf("1.%s.%s.%s", area, exchange, line)
+	}
+	return fmt.Sprintf("+%d %s %s %s", r.Intn(89)+10, randomDigits(r, 2), exchange, line)
+}
+
+// nanpAreaCode generates a NANP area code accepted by PhonesWithExtsPattern
+func nanpAreaCode(r *rand.Rand) string {
+	return fmt.Sprintf("%d%d%s", r.Intn(8)+2, r.Intn(9), string("023456789"[r.Intn(9)]))
+}
+
+// nanpExchange generates a NANP exchange code accepted by PhonesWithExtsPattern
+func nanpExchange(r *rand.Rand) string {
+	return fmt.Sprintf("%d%s%s", r.Intn(8)+2, string("023456789"[r.Intn(9)]), string("023456789"[r.Intn(9)]))
+}
+
+// PhoneWithExt generates a North American phone number with an extension
+// such as "(523)222-8888 ext 527" or "523-222-8888 x623"
+func PhoneWithExt(r *rand.Rand) string {
+	area := nanpAreaCode(r)
+	exchange := nanpExchange(r)
+	line := randomDigits(r, 4)
+	// "extension" is left out as PhonesWithExtsPattern stops at "ext".
+	exts := []string{" ext ", " ext. ", "x", " x", " #", " x. "}
+	ext := pick(r, exts) + strconv.Itoa(r.Intn(9999)+1)
+	if r.Intn(2) == 0 {
+		return fmt.Sprintf("(%s)%s-%s%s", area, exchange, line, ext)
+	}
+	return fmt.Sprintf("%s-%s-%s%s", area, exchange, line, ext)
+}
+
+func domain(r *rand.Rand) string {
+	name := pick(r, domainNames)
+	if r.Intn(3) == 0 {
+		name = randomString(r, lowerLetters, 3) + "." + name
+	}
+	return name + "." + pick(r, topLevelDomains)
+}
+
+// Link generates a link such as "www.example.com", "https://example.org/a/b"
+// or "http://sub.example.net/?q=dog"
+func Link(r *rand.Rand) string {
+	host := domain(r)
+	if r.Intn(2) == 0 {
+		host = "www." + host
+	}
+	switch r.Intn(4) {
+	case 0:
+		return host
+	case 1:
+		return "http://" + host
+	case 2:
+		return "https://" + host + "/" + randomString(r, lowerAlnum, r.Intn(8)+1)
+	}
+	return "https://" + host + "/?q=" + randomString(r, lowerAlnum, r.Intn(8)+1)
+}
+
+// Domain generates a host name such as "example.com", "www.github.co.uk" or
+// an internationalized one such as "bücher.de" or its punycode form
+func Domain(r *rand.Rand) string {
+	host := domain(r)
+	switch r.Intn(4) {
+	case 0:
+		host = "www." + host
+	case 1:
+		host = pick(r, internationalDomains)
+	}
+	return host
+}
+
+// Email generates an email address such as "john.smith@example.com"
+func Email(r *rand.Rand) string {
+	local := randomString(r, lowerLetters, r.Intn(8)+1)
+	switch r.Intn(3) {
+	case 0:
+		local += "." + randomString(r, lowerAlnum, r.Intn(8)+1)
+	case 1:
+		local += "_" + randomString(r, lowerAlnum, r.Intn(8)+1)
+	}
+	return local + "@" + domain(r)
+}
+
+// IPv4 generates a dotted-quad IPv4 address
+func IPv4(r *rand.Rand) string {
+	return fmt.Sprintf("%d.%d.%d.%d", r.Intn(256), r.Intn(256), r.Intn(256), r.Intn(256))
+}
+
+// IPv6 generates an IPv6 address, either fully expanded or with a run of
+// groups compressed to "::"
+func IPv6(r *rand.Rand) string {
+	groups := make([]string, 8)
+	for i := range groups {
+		groups[i] = strconv.FormatUint(uint64(r.Intn(0x10000)), 16)
+	}
+	if r.Intn(2) == 0 {
+		return strings.Join(groups, ":")
+	}
+	// Compress a run of groups in the middle, keeping at least one group on
+	// each side so the address is never just "::".
+	start := r.Intn(6) + 1
+	end := start + r.Intn(7-start) + 1
+	return strings.Join(groups[:start], ":") + "::" + strings.Join(groups[end:], ":")
+}
+
+// IP generates either an IPv4 or an IPv6 address
+func IP(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return IPv4(r)
+	}
+	return IPv6(r)
+}
+
+// NotKnownPort generates a port number outside of the well-known range
+func NotKnownPort(r *rand.Rand) string {
+	// NotKnownPortPattern only matches ports above 59999 partially, so they
+	// are left out.
+	return strconv.Itoa(1024 + r.Intn(60000-1024))
+}
+
+// groupThousands writes the digits of n in groups of three separated by sep
+func groupThousands(n int, sep byte) string {
+	var b strings.Builder
+	s := strconv.Itoa(n)
+	for i, c := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			b.WriteByte(sep)
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// groupLakhs writes n with Indian digit grouping, like "12,34,567": the last
+// three digits, then groups of two
+func groupLakhs(n int) string {
+	s := strconv.Itoa(n)
+	if len(s) <= 3 {
+		return s
+	}
+	head, tail := s[:len(s)-3], s[len(s)-3:]
+	var b strings.Builder
+	for i, c := range head {
+		if i > 0 && (len(head)-i)%2 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(c)
+	}
+	return b.String() + "," + tail
+}
+
+// Price generates an amount of money such as "$10,000.00", "€1.200,50",
+// "USD 1,200", "1.200,50 EUR", "-£5.99", "(¥1,000)", "(₹12,34,567)" or "$5k"
+func Price(r *rand.Rand) string {
+	// PricePattern reads digits in groups of three, so amounts above 999 are
+	// always written with thousands separators.
+	n := r.Intn(10000000)
+	switch r.Intn(6) {
+	case 0:
+		amount := groupThousands(n, ',')
+		if r.Intn(2) == 0 {
+			amount += fmt.Sprintf(".%02d", r.Intn(100))
+		}
+		return "$" + amount
+	case 1:
+		amount := groupThousands(n, '.')
+		if r.Intn(2) == 0 {
+			amount += fmt.Sprintf(",%02d", r.Intn(100))
+		}
+		if r.Intn(2) == 0 {
+			return pick(r, []string{"€", "R$ ", "EUR ", "CHF "}) + amount
+		}
+		return amount + pick(r, []string{" €", "€", " EUR", " DKK"})
+	case 2:
+		return pick(r, []string{"USD ", "GBP ", "US$", "C$", "A$"}) + groupThousands(n, ',')
+	case 3:
+		return pick(r, []string{"-£", "-€", "£-", "USD -"}) + fmt.Sprintf("%d.%02d", r.Intn(1000), r.Intn(100))
+	case 4:
+		symbol := pick(r, []string{"¥", "₹", "₩", "$"})
+		if symbol == "₹" && r.Intn(2) == 0 {
+			return "(" + symbol + groupLakhs(n) + ")"
+		}
+		return "(" + symbol + groupThousands(n, ',') + ")"
+	default:
+		return fmt.Sprintf("%s%d%s", pick(r, []string{"$", "£", "€"}), 1+r.Intn(999), pick(r, []string{"k", "K", "M", "mn", "bn"}))
+	}
+}
+
+// HexColor generates a hex color such as "#fff" or "#4e32ff"
+func HexColor(r *rand.Rand) string {
+	n := 6
+	if r.Intn(2) == 0 {
+		n = 3
+	}
+	color := randomString(r, hexDigits, n)
+	if r.Intn(2) == 0 {
+		color = strings.ToUpper(color)
+	}
+	return "#" + color
+}
+
+// luhnCheckDigit returns the check digit that makes digits+check Luhn-valid
+func luhnCheckDigit(digits string) int {
+	sum := 0
+	double := true
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return (10 - sum%10) % 10
+}
+
+func luhnNumber(r *rand.Rand, prefix string, length int) string {
+	body := prefix + randomDigits(r, length-len(prefix)-1)
+	return body + strconv.Itoa(luhnCheckDigit(body))
+}
+
+func groupCardNumber(r *rand.Rand, number string) string {
+	sep := []string{"", " ", "-"}[r.Intn(3)]
+	return number[0:4] + sep + number[4:8] + sep + number[8:12] + sep + number[12:16]
+}
+
+// CreditCard generates a Luhn-valid 16 digit card number, grouped by four
+// digits with spaces or dashes or written without separators
+func CreditCard(r *rand.Rand) string {
+	return groupCardNumber(r, luhnNumber(r, strconv.Itoa(r.Intn(9)+1), 16))
+}
+
+// VISACreditCard generates a Luhn-valid VISA card number
+func VISACreditCard(r *rand.Rand) string {
+	return groupCardNumber(r, luhnNumber(r, "4", 16))
+}
+
+// MCCreditCard generates a Luhn-valid MasterCard card number
+func MCCreditCard(r *rand.Rand) string {
+	return groupCardNumber(r, luhnNumber(r, "5"+strconv.Itoa(r.Intn(5)+1), 16))
+}
+
+func base58Encode(b []byte) string {
+	n := new(big.Int).SetBytes(b)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+func base58CheckEncode(version byte, payload []byte) string {
+	data := append([]byte{version}, payload...)
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	return base58Encode(append(data, second[:4]...))
+}
+
+// BtcAddress generates a base58check-valid P2PKH ("1...") or P2SH ("3...")
+// bitcoin address
+func BtcAddress(r *rand.Rand) string {
+	version := byte(0x00)
+	if r.Intn(2) == 0 {
+		version = 0x05
+	}
+	return base58CheckEncode(version, randomBytes(r, 20))
+}
+
+// StreetAddress generates a street address such as "123 Main street"
+func StreetAddress(r *rand.Rand) string {
+	return fmt.Sprintf("%d %s %s", r.Intn(9999)+1, pick(r, streetNames), pick(r, streetSuffixes))
+}
+
+// ZipCode generates a US zip code such as "02215" or "02215-1234"
+func ZipCode(r *rand.Rand) string {
+	zip := randomDigits(r, 5)
+	if r.Intn(2) == 0 {
+		zip += string("- "[r.Intn(2)]) + randomDigits(r, 4)
+	}
+	return zip
+}
+
+// PoBox generates a post office box such as "P.O. Box 123" or "PO Box 42"
+func PoBox(r *rand.Rand) string {
+	prefixes := []string{"P.O. Box", "PO Box", "P.O Box", "po box", "P. O. Box"}
+	return fmt.Sprintf("%s %d", pick(r, prefixes), r.Intn(99999)+1)
+}
+
+// SSN generates a US social security number. The area, group and serial
+// numbers are never in the ranges the SSA does not assign.
+func SSN(r *rand.Rand) string {
+	area := r.Intn(898) + 1
+	if area >= 666 {
+		area++
+	}
+	return fmt.Sprintf("%03d-%02d-%04d", area, r.Intn(99)+1, r.Intn(9999)+1)
+}
+
+// MD5Hex generates an MD5 digest in hex
+func MD5Hex(r *rand.Rand) string {
+	return fmt.Sprintf("%x", md5.Sum(randomBytes(r, 16)))
+}
+
+// SHA1Hex generates a SHA1 digest in hex
+func SHA1Hex(r *rand.Rand) string {
+	return fmt.Sprintf("%x", sha1.Sum(randomBytes(r, 16)))
+}
+
+// SHA256Hex generates a SHA256 digest in hex
+func SHA256Hex(r *rand.Rand) string {
+	return fmt.Sprintf("%x", sha256.Sum256(randomBytes(r, 16)))
+}
+
+// GUID generates a version 4 GUID, with or without dashes
+func GUID(r *rand.Rand) string {
+	b := randomBytes(r, 16)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	if r.Intn(2) == 0 {
+		return fmt.Sprintf("%x", b)
+	}
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+func isbn13CheckDigit(digits string) int {
+	sum := 0
+	for i, c := range digits {
+		d := int(c - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10 - sum%10) % 10
+}
+
+func isbn10CheckDigit(digits string) string {
+	sum := 0
+	for i, c := range digits {
+		sum += (10 - i) * int(c-'0')
+	}
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return "X"
+	}
+	return strconv.Itoa(check)
+}
+
+// ISBN13 generates a valid ISBN-13 such as "978-3-16-148410-0", with or
+// without hyphens
+func ISBN13(r *rand.Rand) string {
+	prefix := []string{"978", "979"}[r.Intn(2)]
+	group := randomDigits(r, 1)
+	publisher := randomDigits(r, 2+r.Intn(4))
+	title := randomDigits(r, 12-len(prefix)-len(group)-len(publisher))
+	check := isbn13CheckDigit(prefix + group + publisher + title)
+	if r.Intn(2) == 0 {
+		return fmt.Sprintf("%s%s%s%s%d", prefix, group, publisher, title, check)
+	}
+	return fmt.Sprintf("%s-%s-%s-%s-%d", prefix, group, publisher, title, check)
+}
+
+// ISBN10 generates a valid ISBN-10 such as "1-56619-909-3", with or without
+// hyphens
+func ISBN10(r *rand.Rand) string {
+	group := randomDigits(r, 1)
+	publisher := randomDigits(r, 2+r.Intn(4))
+	title := randomDigits(r, 9-len(group)-len(publisher))
+	check := isbn10CheckDigit(group + publisher + title)
+	if r.Intn(2) == 0 {
+		return group + publisher + title + check
+	}
+	return fmt.Sprintf("%s-%s-%s-%s", group, publisher, title, check)
+}
+
+// MACAddress generates a MAC address such as "f8:2f:a4:fe:76:d2"
+func MACAddress(r *rand.Rand) string {
+	b := randomBytes(r, 6)
+	sep := string(":-"[r.Intn(2)])
+	mac := fmt.Sprintf("%02x%s%02x%s%02x%s%02x%s%02x%s%02x",
+		b[0], sep, b[1], sep, b[2], sep, b[3], sep, b[4], sep, b[5])
+	if r.Intn(2) == 0 {
+		mac = strings.ToUpper(mac)
+	}
+	return mac
+}
+
+// ibanFormats maps a country code to a generator of its BBAN (the part after
+// the check digits)
+var ibanFormats = map[string]func(r *rand.Rand) string{
+	"DE": func(r *rand.Rand) string { return randomDigits(r, 18) },
+	"FR": func(r *rand.Rand) string { return randomDigits(r, 23) },
+	"ES": func(r *rand.Rand) string { return randomDigits(r, 20) },
+	"IT": func(r *rand.Rand) string {
+		return randomString(r, upperLetters, 1) + randomDigits(r, 10) + randomDigits(r, 12)
+	},
+	"GB": func(r *rand.Rand) string { return randomString(r, upperLetters, 4) + randomDigits(r, 14) },
+	"NL": func(r *rand.Rand) string { return randomString(r, upperLetters, 4) + randomDigits(r, 10) },
+	"NO": func(r *rand.Rand) string { return randomDigits(r, 11) },
+	"CH": func(r *rand.Rand) string { return randomDigits(r, 17) },
+}
+
+var ibanCountries = []string{"DE", "FR", "ES", "IT", "GB", "NL", "NO", "CH"}
+
+// ibanCheckDigits computes the ISO 7064 mod 97-10 check digits for an IBAN
+// with the given country code and BBAN
+func ibanCheckDigits(country, bban string) string {
+	rearranged := bban + country + "00"
+	remainder := 0
+	for _, c := range rearranged {
+		var v int
+		if c >= 'A' && c <= 'Z' {
+			v = int(c-'A') + 10
+			remainder = (remainder*100 + v) % 97
+			continue
+		}
+		v = int(c - '0')
+		remainder = (remainder*10 + v) % 97
+	}
+	return fmt.Sprintf("%02d", 98-remainder)
+}
+
+// IBAN generates a mod-97-valid IBAN for one of a handful of European
+// countries, using the country's real BBAN length
+func IBAN(r *rand.Rand) string {
+	country := pick(r, ibanCountries)
+	bban := ibanFormats[country](r)
+	return country + ibanCheckDigits(country, bban) + bban
+}
+
+// GitRepo generates a git repository address such as
+// "https://github.com/mingrammer/commonregex.git" or
+// "git@github.com:mingrammer/commonregex.git"
+func GitRepo(r *rand.Rand) string {
+	host := pick(r, gitHosts)
+	user := randomString(r, lowerAlnum, r.Intn(10)+1)
+	repo := randomString(r, lowerAlnum, r.Intn(10)+1)
+	switch r.Intn(3) {
+	case 0:
+		return fmt.Sprintf("git@%s:%s/%s.git", host, user, repo)
+	case 1:
+		return fmt.Sprintf("ssh://git@%s/%s/%s.git", host, user, repo)
+	}
+	return fmt.Sprintf("https://%s/%s/%s.git", host, user, repo)
+}
@@ -0,0 +1,244 @@
+package generator
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mingrammer/commonregex"
+	"github.com/stretchr/testify/assert"
+)
+
+const iterations = 500
+
+func TestGenerator_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		generate func(*rand.Rand) string
+		find     func(string, ...commonregex.Option) []string
+	}{
+		{"Date", Date, commonregex.Date},
+		{"Time", Time, commonregex.Time},
+		{"Timestamp", Timestamp, commonregex.Timestamps},
+		{"Phone", Phone, commonregex.Phones},
+		{"PhoneWithExt", PhoneWithExt, commonregex.PhonesWithExts},
+		{"Link", Link, commonregex.Links},
+		{"Domain", Domain, commonregex.Domains},
+		{"Email", Email, commonregex.Emails},
+		{"IPv4", IPv4, commonregex.IPv4s},
+		{"IPv6", IPv6, commonregex.IPv6s},
+		{"IP", IP, commonregex.IPs},
+		{"NotKnownPort", NotKnownPort, commonregex.NotKnownPorts},
+		{"Price", Price, commonregex.Prices},
+		{"HexColor", HexColor, commonregex.HexColors},
+		{"CreditCard", CreditCard, commonregex.CreditCards},
+		{"VISACreditCard", VISACreditCard, commonregex.VISACreditCards},
+		{"MCCreditCard", MCCreditCard, commonregex.MCCreditCards},
+		{"BtcAddress", BtcAddress, commonregex.BtcAddresses},
+		{"EthAddress", EthAddress, commonregex.EthAddresses},
+		{"LtcAddress", LtcAddress, commonregex.LtcAddresses},
+		{"BchAddress", BchAddress, commonregex.BchAddresses},
+		{"DogeAddress", DogeAddress, commonregex.DogeAddresses},
+		{"XmrAddress", XmrAddress, commonregex.XmrAddresses},
+		{"TrxAddress", TrxAddress, commonregex.TrxAddresses},
+		{"XrpAddress", XrpAddress, commonregex.XrpAddresses},
+		{"StreetAddress", StreetAddress, commonregex.StreetAddresses},
+		{"ZipCode", ZipCode, commonregex.ZipCodes},
+		{"PoBox", PoBox, commonregex.PoBoxes},
+		{"SSN", SSN, commonregex.SSNs},
+		{"NINO", NINO, commonregex.NINOs},
+		{"SIN", SIN, commonregex.SINs},
+		{"NIR", NIR, commonregex.NIRs},
+		{"SteuerID", SteuerID, commonregex.SteuerIDs},
+		{"FiscalCode", FiscalCode, commonregex.FiscalCodes},
+		{"DNI", DNI, commonregex.DNIs},
+		{"NIE", NIE, commonregex.NIEs},
+		{"BSN", BSN, commonregex.BSNs},
+		{"Aadhaar", Aadhaar, commonregex.Aadhaars},
+		{"PAN", PAN, commonregex.PANs},
+		{"CPF", CPF, commonregex.CPFs},
+		{"CNPJ", CNPJ, commonregex.CNPJs},
+		{"RRN", RRN, commonregex.RRNs},
+		{"MD5Hex", MD5Hex, commonregex.MD5Hexes},
+		{"SHA1Hex", SHA1Hex, commonregex.SHA1Hexes},
+		{"SHA256Hex", SHA256Hex, commonregex.SHA256Hexes},
+		{"GUID", GUID, commonregex.GUIDs},
+		{"ISBN13", ISBN13, commonregex.ISBN13s},
+		{"ISBN10", ISBN10, commonregex.ISBN10s},
+		{"MACAddress", MACAddress, commonregex.MACAddresses},
+		{"IBAN", IBAN, commonregex.IBANs},
+		{"GitRepo", GitRepo, commonregex.GitRepos},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			assert := assert.New(t)
+
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < iterations; i++ {
+				value := test.generate(r)
+				assert.Equal([]string{value}, test.find(value), "generated value should round-trip")
+			}
+		})
+	}
+}
+
+func TestGenerator_RoundTripWithKeyword(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		keyword  string
+		generate func(*rand.Rand) string
+		find     func(string, ...commonregex.Option) []string
+	}{
+		{"Passport", "Passport no.", Passport, commonregex.Passports},
+		{"DriversLicense", "Driver's license", DriversLicense, commonregex.DriversLicenses},
+		{"EIN", "EIN", EIN, commonregex.EINs},
+		{"ITIN", "ITIN", ITIN, commonregex.ITINs},
+		{"EpochTime", "timestamp", EpochTime, commonregex.EpochTimes},
+		{"SolAddress", "Solana wallet", SolAddress, commonregex.SolAddresses},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			assert := assert.New(t)
+
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < iterations; i++ {
+				value := test.generate(r)
+				assert.Equal([]string{value}, test.find(test.keyword+" "+value), "generated value should round-trip")
+				assert.Empty(test.find(value), "generated value should not be found without a keyword")
+			}
+		})
+	}
+}
+
+func TestGenerator_Deterministic(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	a := rand.New(rand.NewSource(42))
+	b := rand.New(rand.NewSource(42))
+	for i := 0; i < iterations; i++ {
+		assert.Equal(IBAN(a), IBAN(b), "same seed should generate the same values")
+	}
+}
+
+func luhnValid(number string) bool {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(number)
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+func TestGenerator_Luhn(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	assert.Equal(1, luhnCheckDigit("411111111111111"))
+	assert.Equal(4, luhnCheckDigit("550000000000000"))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < iterations; i++ {
+		for _, number := range []string{CreditCard(r), VISACreditCard(r), MCCreditCard(r)} {
+			assert.True(luhnValid(number), "%s should be Luhn-valid", number)
+		}
+	}
+}
+
+func TestGenerator_IBANCheckDigits(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	assert.Equal("29", ibanCheckDigits("GB", "NWBK60161331926819"))
+	assert.Equal("89", ibanCheckDigits("DE", "370400440532013000"))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < iterations; i++ {
+		iban := IBAN(r)
+		assert.Equal(iban[2:4], ibanCheckDigits(iban[:2], iban[4:]), "%s should be mod-97-valid", iban)
+	}
+}
+
+func TestGenerator_ISBNCheckDigits(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	assert.Equal(0, isbn13CheckDigit("978316148410"))
+	assert.Equal(4, isbn13CheckDigit("978156619909"))
+	assert.Equal("3", isbn10CheckDigit("156619909"))
+	assert.Equal("X", isbn10CheckDigit("080442957"))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < iterations; i++ {
+		isbn := strings.Replace(ISBN13(r), "-", "", -1)
+		assert.Equal(strconv.Itoa(isbn13CheckDigit(isbn[:12])), isbn[12:])
+		isbn = strings.Replace(ISBN10(r), "-", "", -1)
+		assert.Equal(isbn10CheckDigit(isbn[:9]), isbn[9:])
+	}
+}
+
+func TestGenerator_BtcAddress(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	// The genesis block coinbase address.
+	hash := []byte{
+		0x62, 0xe9, 0x07, 0xb1, 0x5c, 0xbf, 0x27, 0xd5, 0x42, 0x53,
+		0x99, 0xeb, 0xf6, 0xf0, 0xfb, 0x50, 0xeb, 0xb8, 0x8f, 0x18,
+	}
+	assert.Equal("1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", base58CheckEncode(0x00, hash))
+}
+
+func TestGenerator_CryptoAddresses(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	// The segwit example of BIP 173.
+	program, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+	assert.Equal("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", segwitEncode("bc", program))
+
+	// The CashAddr form of 1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu.
+	hash, _ := hex.DecodeString("76a04053bda0a88bda5177b86a15c3b29f559873")
+	assert.Equal("qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", cashAddrEncode("bitcoincash", 0x00, hash))
+
+	// The Monero general fund address.
+	data, _ := hex.DecodeString("1242f18fc61586554095b0799b5c4b6f00cdeb26a93b20540d366932c6001617b75db35109fbba7d5f275fef4b9c49e0cc1c84b219ec6ff652fda54f89f7f63c887ec4a75d")
+	assert.Equal("44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A", moneroBase58Encode(data))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < iterations; i++ {
+		address := EthAddress(r)
+		assert.Len(address, 42)
+		assert.NotEqual(strings.ToLower(address), address)
+	}
+}
+
+func TestGenerator_NationalIDCheckDigits(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	assert.Equal(6, verhoeffCheckDigit("23412341234"))
+	assert.Equal("2", mod11CheckDigit("529982247", 11))
+	assert.Equal("5", mod11CheckDigit("5299822472", 11))
+	assert.Equal("8", mod11CheckDigit("112223330001", 9))
+	assert.Equal("1", mod11CheckDigit("1122233300018", 9))
+}
@@ -0,0 +1,271 @@
+package generator
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// NINO generates a UK National Insurance number such as "AB 12 34 56 C"
+func NINO(r *rand.Rand) string {
+	const first, second = "ABCEGHJKLMNPRSTWXYZ", "ABCEGHJKLMNPRSTWXYZ"
+	prefix := "GB"
+	for prefix == "GB" || prefix == "BG" || prefix == "NK" || prefix == "KN" ||
+		prefix == "TN" || prefix == "NT" || prefix == "ZZ" {
+		prefix = randomString(r, first, 1) + randomString(r, second, 1)
+	}
+	digits := randomDigits(r, 6)
+	suffix := randomString(r, "ABCD", 1)
+	if r.Intn(2) == 0 {
+		return prefix + digits + suffix
+	}
+	return fmt.Sprintf("%s %s %s %s %s", prefix, digits[:2], digits[2:4], digits[4:], suffix)
+}
+
+// SIN generates a Luhn-valid Canadian Social Insurance Number such as
+// "130 692 544"
+func SIN(r *rand.Rand) string {
+	number := luhnNumber(r, randomString(r, "1234567", 1), 9)
+	sep := []string{"", " ", "-"}[r.Intn(3)]
+	return number[:3] + sep + number[3:6] + sep + number[6:]
+}
+
+// NIR generates a French social security number with a valid key, such as
+// "1 84 12 76 451 089 46"
+func NIR(r *rand.Rand) string {
+	department := fmt.Sprintf("%02d", r.Intn(95)+1)
+	corsica := ""
+	switch department {
+	case "20":
+		corsica = []string{"2A", "2B"}[r.Intn(2)]
+		department = map[string]string{"2A": "19", "2B": "18"}[corsica]
+	}
+	body := fmt.Sprintf("%d%02d%02d%s%03d%03d", r.Intn(2)+1, r.Intn(100), r.Intn(12)+1, department, r.Intn(990)+1, r.Intn(999)+1)
+	n, _ := strconv.ParseInt(body, 10, 64)
+	key := fmt.Sprintf("%02d", 97-n%97)
+	if corsica != "" {
+		body = body[:5] + corsica + body[7:]
+	}
+	if r.Intn(2) == 0 {
+		return body + key
+	}
+	return strings.Join([]string{body[:1], body[1:3], body[3:5], body[5:7], body[7:10], body[10:13], key}, " ")
+}
+
+// SteuerID generates a German tax identification number with a valid check
+// digit, in which one digit of the first ten appears twice
+func SteuerID(r *rand.Rand) string {
+	digits := r.Perm(10)
+	for digits[0] == 0 {
+		digits = r.Perm(10)
+	}
+	// repeat one digit in place of another, keeping the first one
+	from, to := r.Intn(10), r.Intn(9)+1
+	for from == to {
+		to = r.Intn(9) + 1
+	}
+	digits[to] = digits[from]
+	var b strings.Builder
+	product := 10
+	for _, d := range digits {
+		b.WriteByte(byte('0' + d))
+		sum := (d + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = sum * 2 % 11
+	}
+	check := 11 - product
+	if check == 10 {
+		check = 0
+	}
+	b.WriteByte(byte('0' + check))
+	return b.String()
+}
+
+// fiscalCodeOdd holds the values of the characters in the odd positions of an
+// Italian fiscal code, indexed by digit or letter
+var fiscalCodeOdd = [26]int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23}
+
+// FiscalCode generates an Italian fiscal code (codice fiscale) with a valid
+// check letter, such as "RSSMRA85T10A562S"
+func FiscalCode(r *rand.Rand) string {
+	const upper = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	day := r.Intn(28) + 1
+	if r.Intn(2) == 0 {
+		day += 40
+	}
+	code := randomString(r, upper, 6) + randomDigits(r, 2) + randomString(r, "ABCDEHLMPRST", 1) +
+		fmt.Sprintf("%02d", day) + randomString(r, upper, 1) + randomDigits(r, 3)
+	sum := 0
+	for i := 0; i < len(code); i++ {
+		v := int(code[i] - 'A')
+		if code[i] <= '9' {
+			v = int(code[i] - '0')
+		}
+		if i%2 == 0 {
+			sum += fiscalCodeOdd[v]
+		} else {
+			sum += v
+		}
+	}
+	return code + string(rune('A'+sum%26))
+}
+
+const dniLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+
+// DNI generates a Spanish national identity number with a valid check letter,
+// such as "12345678Z"
+func DNI(r *rand.Rand) string {
+	n := r.Intn(100000000)
+	return fmt.Sprintf("%08d%c", n, dniLetters[n%23])
+}
+
+// NIE generates a Spanish foreigner identity number with a valid check
+// letter, such as "X1234567L"
+func NIE(r *rand.Rand) string {
+	prefix, n := r.Intn(3), r.Intn(10000000)
+	return fmt.Sprintf("%c%07d%c", "XYZ"[prefix], n, dniLetters[(prefix*10000000+n)%23])
+}
+
+// BSN generates a Dutch citizen service number that passes the eleven test,
+// such as "111222333" or "1112.22.333"
+func BSN(r *rand.Rand) string {
+	for {
+		digits := randomDigits(r, 8)
+		sum := 0
+		for i := 0; i < 8; i++ {
+			sum += (9 - i) * int(digits[i]-'0')
+		}
+		check := sum % 11
+		if check == 10 || digits == "00000000" {
+			continue
+		}
+		digits += strconv.Itoa(check)
+		if r.Intn(2) == 0 {
+			return digits
+		}
+		return digits[:4] + "." + digits[4:6] + "." + digits[6:]
+	}
+}
+
+// Verhoeff tables: the multiplication table of the dihedral group D5, the
+// permutation applied at each position and the inverses
+var (
+	verhoeffMultiplication = [10][10]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+		{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+		{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+		{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+		{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+		{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+		{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+		{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+		{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+	}
+	verhoeffPermutation = [8][10]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+		{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+		{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+		{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+		{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+		{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+		{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+	}
+	verhoeffInverse = [10]int{0, 4, 3, 2, 1, 5, 6, 7, 8, 9}
+)
+
+func verhoeffCheckDigit(digits string) int {
+	c := 0
+	for i := 0; i < len(digits); i++ {
+		d := int(digits[len(digits)-1-i] - '0')
+		c = verhoeffMultiplication[c][verhoeffPermutation[(i+1)%8][d]]
+	}
+	return verhoeffInverse[c]
+}
+
+// Aadhaar generates an Indian Aadhaar number with a valid Verhoeff check
+// digit, such as "2341 2341 2346"
+func Aadhaar(r *rand.Rand) string {
+	digits := randomString(r, "23456789", 1) + randomDigits(r, 10)
+	digits += strconv.Itoa(verhoeffCheckDigit(digits))
+	sep := []string{"", " ", "-"}[r.Intn(3)]
+	return digits[:4] + sep + digits[4:8] + sep + digits[8:]
+}
+
+// PAN generates an Indian Permanent Account Number such as "ABCPE1234F"
+func PAN(r *rand.Rand) string {
+	const upper = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	return randomString(r, upper, 3) + randomString(r, "ABCFGHJLPT", 1) + randomString(r, upper, 1) +
+		randomDigits(r, 4) + randomString(r, upper, 1)
+}
+
+// mod11CheckDigit returns the check digit of CPF and CNPJ numbers
+func mod11CheckDigit(digits string, maxWeight int) string {
+	sum, weight := 0, 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += weight * int(digits[i]-'0')
+		weight++
+		if weight > maxWeight {
+			weight = 2
+		}
+	}
+	if rem := sum % 11; rem >= 2 {
+		return strconv.Itoa(11 - rem)
+	}
+	return "0"
+}
+
+// CPF generates a Brazilian individual taxpayer number with valid check
+// digits, such as "529.982.247-25"
+func CPF(r *rand.Rand) string {
+	digits := randomDigits(r, 9)
+	for digits == strings.Repeat(digits[:1], 9) {
+		digits = randomDigits(r, 9)
+	}
+	digits += mod11CheckDigit(digits, 11)
+	digits += mod11CheckDigit(digits, 11)
+	if r.Intn(2) == 0 {
+		return digits
+	}
+	return digits[:3] + "." + digits[3:6] + "." + digits[6:9] + "-" + digits[9:]
+}
+
+// CNPJ generates a Brazilian company registration number with valid check
+// digits, such as "11.222.333/0001-81"
+func CNPJ(r *rand.Rand) string {
+	digits := randomDigits(r, 8) + fmt.Sprintf("%04d", r.Intn(9)+1)
+	digits += mod11CheckDigit(digits, 9)
+	digits += mod11CheckDigit(digits, 9)
+	if r.Intn(2) == 0 {
+		return digits
+	}
+	return digits[:2] + "." + digits[2:5] + "." + digits[5:8] + "/" + digits[8:12] + "-" + digits[12:]
+}
+
+// rrnWeights are the weights of the first twelve digits of a Korean resident
+// registration number
+var rrnWeights = [12]int{2, 3, 4, 5, 6, 7, 8, 9, 2, 3, 4, 5}
+
+// RRN generates a Korean resident registration number of someone born
+// between 1920 and 2019, with a valid check digit, such as "900101-1234568"
+func RRN(r *rand.Rand) string {
+	year := 1920 + r.Intn(100)
+	gender := 1 + r.Intn(2)
+	if year >= 2000 {
+		gender += 2
+	}
+	digits := fmt.Sprintf("%02d%02d%02d%d%05d", year%100, r.Intn(12)+1, r.Intn(28)+1, gender, r.Intn(100000))
+	sum := 0
+	for i, w := range rrnWeights {
+		sum += w * int(digits[i]-'0')
+	}
+	digits += strconv.Itoa((11 - sum%11) % 10)
+	if r.Intn(2) == 0 {
+		return digits
+	}
+	return digits[:6] + "-" + digits[6:]
+}
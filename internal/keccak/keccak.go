@@ -0,0 +1,89 @@
+// Package keccak implements the Keccak-256 hash used by Ethereum and Monero.
+// It is the original Keccak submission, which pads differently from the
+// SHA3-256 that was standardized later.
+package keccak
+
+import "encoding/binary"
+
+// rate is the number of bytes absorbed per permutation for a 256-bit output
+const rate = 136
+
+var roundConstants = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808a, 0x8000000080008000,
+	0x000000000000808b, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008a, 0x0000000000000088, 0x0000000080008009, 0x000000008000000a,
+	0x000000008000808b, 0x800000000000008b, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800a, 0x800000008000000a,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+var rotations = [25]uint{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// permute applies Keccak-f[1600] to the state, whose lanes are indexed x+5y
+func permute(a *[25]uint64) {
+	var b [25]uint64
+	var c, d [5]uint64
+	for _, rc := range roundConstants {
+		// θ
+		for x := 0; x < 5; x++ {
+			c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+		for x := 0; x < 5; x++ {
+			d[x] = c[(x+4)%5] ^ (c[(x+1)%5]<<1 | c[(x+1)%5]>>63)
+		}
+		for i := range a {
+			a[i] ^= d[i%5]
+		}
+		// ρ and π
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				r := rotations[x+5*y]
+				b[y+5*((2*x+3*y)%5)] = a[x+5*y]<<r | a[x+5*y]>>((64-r)%64)
+			}
+		}
+		// χ
+		for y := 0; y < 25; y += 5 {
+			for x := 0; x < 5; x++ {
+				a[y+x] = b[y+x] ^ (^b[y+(x+1)%5] & b[y+(x+2)%5])
+			}
+		}
+		// ι
+		a[0] ^= rc
+	}
+}
+
+// Sum256 returns the Keccak-256 digest of data
+func Sum256(data []byte) [32]byte {
+	var a [25]uint64
+	block := make([]byte, rate)
+	for len(data) >= rate {
+		absorb(&a, data[:rate])
+		data = data[rate:]
+	}
+	n := copy(block, data)
+	for i := n; i < rate; i++ {
+		block[i] = 0
+	}
+	block[n] ^= 0x01
+	block[rate-1] ^= 0x80
+	absorb(&a, block)
+
+	var digest [32]byte
+	for i := 0; i < 4; i++ {
+		binary.LittleEndian.PutUint64(digest[8*i:], a[i])
+	}
+	return digest
+}
+
+func absorb(a *[25]uint64, block []byte) {
+	for i := 0; i < rate/8; i++ {
+		a[i] ^= binary.LittleEndian.Uint64(block[8*i:])
+	}
+	permute(a)
+}
@@ -0,0 +1,165 @@
+package commonregex
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// iocKinds are the kinds ExtractIOCs finds by default
+var iocKinds = []Kind{KindIPv4, KindLink, KindEmail, KindMD5Hex, KindSHA1Hex, KindSHA256Hex}
+
+// IOC is an indicator of compromise found by ExtractIOCs
+type IOC struct {
+	Kind Kind
+	// Value is the refanged indicator, like "https://evil.com"
+	Value string
+	// Defanged is the indicator as written in the text, like
+	// "hxxps://evil[.]com". It is text[Start:End].
+	Defanged   string
+	Start, End int
+}
+
+// ExtractIOCs finds the indicators of compromise of the given kinds in text,
+// or IPv4 addresses, links, emails and MD5, SHA1 and SHA256 hashes if no kinds
+// are given. Defanged indicators, like "192[.]168[.]1[.]1", "user[@]mail.ru"
+// or "hxxps://evil[.]com", are refanged before matching. Links which are only
+// the domain of an email address or an IPv4 address are left out. The IOCs
+// are returned in the order they appear in text.
+func ExtractIOCs(text string, kinds ...Kind) []IOC {
+	if len(kinds) == 0 {
+		kinds = iocKinds
+	}
+	matches := refang(newMappedText(text)).scan(kinds)
+
+	var iocs []IOC
+	for i, m := range matches {
+		if m.Kind == KindLink && coveredLink(matches, i) {
+			continue
+		}
+		iocs = append(iocs, IOC{
+			Kind:     m.Kind,
+			Value:    m.Value,
+			Defanged: text[m.Start:m.End],
+			Start:    m.Start,
+			End:      m.End,
+		})
+	}
+	return iocs
+}
+
+// coveredLink reports whether the link matches[i] lies within an email
+// address or is an IPv4 address
+func coveredLink(matches []Match, i int) bool {
+	link := matches[i]
+	for _, m := range matches {
+		switch {
+		case m.Kind == KindEmail && m.Start <= link.Start && link.End <= m.End:
+			return true
+		case m.Kind == KindIPv4 && m.Start == link.Start && m.End == link.End:
+			return true
+		}
+	}
+	return false
+}
+
+// stixNamespace is the namespace of the deterministic identifiers of STIX
+// 2.1 objects
+var stixNamespace = [16]byte{
+	0x00, 0xab, 0xed, 0xb4, 0xaa, 0x42, 0x46, 0x6c,
+	0x9c, 0x01, 0xfe, 0xd2, 0x33, 0x15, 0xa9, 0xb7,
+}
+
+// stixTimestamp is the format of STIX 2.1 timestamps
+const stixTimestamp = "2006-01-02T15:04:05.000Z"
+
+type stixBundle struct {
+	Type    string          `json:"type"`
+	ID      string          `json:"id"`
+	Objects []stixIndicator `json:"objects"`
+}
+
+type stixIndicator struct {
+	Type           string   `json:"type"`
+	SpecVersion    string   `json:"spec_version"`
+	ID             string   `json:"id"`
+	Created        string   `json:"created"`
+	Modified       string   `json:"modified"`
+	Name           string   `json:"name"`
+	IndicatorTypes []string `json:"indicator_types"`
+	Pattern        string   `json:"pattern"`
+	PatternType    string   `json:"pattern_type"`
+	ValidFrom      string   `json:"valid_from"`
+}
+
+// STIXBundle returns a STIX 2.1 bundle of an indicator for each distinct IOC,
+// created at the given time. The identifiers are derived from the values, so
+// the same IOCs always give the same bundle. IOCs of kinds without a STIX
+// pattern are skipped.
+func STIXBundle(iocs []IOC, created time.Time) ([]byte, error) {
+	timestamp := created.UTC().Format(stixTimestamp)
+	bundle := stixBundle{Type: "bundle", Objects: []stixIndicator{}}
+	seen := make(map[string]bool)
+	var ids []string
+	for _, ioc := range iocs {
+		pattern, ok := stixPattern(ioc)
+		if !ok || seen[pattern] {
+			continue
+		}
+		seen[pattern] = true
+		id := "indicator--" + uuid5(stixNamespace, pattern)
+		ids = append(ids, id)
+		bundle.Objects = append(bundle.Objects, stixIndicator{
+			Type:           "indicator",
+			SpecVersion:    "2.1",
+			ID:             id,
+			Created:        timestamp,
+			Modified:       timestamp,
+			Name:           ioc.Value,
+			IndicatorTypes: []string{"malicious-activity"},
+			Pattern:        pattern,
+			PatternType:    "stix",
+			ValidFrom:      timestamp,
+		})
+	}
+	bundle.ID = "bundle--" + uuid5(stixNamespace, strings.Join(ids, ","))
+	return json.Marshal(bundle)
+}
+
+// stixPattern returns the STIX pattern matching the value of ioc
+func stixPattern(ioc IOC) (string, bool) {
+	var path string
+	switch ioc.Kind {
+	case KindIPv4:
+		path = "ipv4-addr:value"
+	case KindIPv6:
+		path = "ipv6-addr:value"
+	case KindLink:
+		path = "url:value"
+	case KindEmail:
+		path = "email-addr:value"
+	case KindMD5Hex:
+		path = "file:hashes.MD5"
+	case KindSHA1Hex:
+		path = "file:hashes.'SHA-1'"
+	case KindSHA256Hex:
+		path = "file:hashes.'SHA-256'"
+	default:
+		return "", false
+	}
+	value := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(ioc.Value)
+	return fmt.Sprintf("[%s = '%s']", path, value), true
+}
+
+// uuid5 returns the name-based UUID of name in namespace
+func uuid5(namespace [16]byte, name string) string {
+	h := sha1.New()
+	h.Write(namespace[:])
+	h.Write([]byte(name))
+	u := h.Sum(nil)[:16]
+	u[6] = u[6]&0x0f | 0x50
+	u[8] = u[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:16])
+}
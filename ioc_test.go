@@ -0,0 +1,93 @@
+package commonregex
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testReport = `The actor staged payloads on hxxps://evil[.]example[.]com/drop.exe and
+beaconed to 192[.]168[.]1[.]1 and 10.0.0.7. Phishing came from
+admin[@]mail[.]ru. Dropper MD5 d41d8cd98f00b204e9800998ecf8427e, SHA256
+e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855.`
+
+func TestExtractIOCs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	iocs := ExtractIOCs(testReport)
+	var got []IOC
+	for _, ioc := range iocs {
+		assert.Equal(ioc.Defanged, testReport[ioc.Start:ioc.End])
+		ioc.Start, ioc.End = 0, 0
+		got = append(got, ioc)
+	}
+	assert.Equal([]IOC{
+		{Kind: KindLink, Value: "https://evil.example.com/drop.exe", Defanged: "hxxps://evil[.]example[.]com/drop.exe"},
+		{Kind: KindIPv4, Value: "192.168.1.1", Defanged: "192[.]168[.]1[.]1"},
+		{Kind: KindIPv4, Value: "10.0.0.7", Defanged: "10.0.0.7"},
+		{Kind: KindEmail, Value: "admin@mail.ru", Defanged: "admin[@]mail[.]ru"},
+		{Kind: KindMD5Hex, Value: "d41d8cd98f00b204e9800998ecf8427e", Defanged: "d41d8cd98f00b204e9800998ecf8427e"},
+		{Kind: KindSHA256Hex, Value: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Defanged: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+	}, got)
+
+	iocs = ExtractIOCs(testReport, KindEmail)
+	assert.Len(iocs, 1)
+	assert.Nil(ExtractIOCs("nothing to see here"))
+}
+
+func TestSTIXBundle(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	created := time.Date(2026, 10, 19, 9, 45, 0, 0, time.UTC)
+	iocs := ExtractIOCs(testReport + " Again: 192.168.1.1, it's " + "hxxp://x[.]com/it's")
+	data, err := STIXBundle(iocs, created)
+	assert.NoError(err)
+
+	var bundle struct {
+		Type    string
+		ID      string
+		Objects []map[string]interface{}
+	}
+	assert.NoError(json.Unmarshal(data, &bundle))
+	assert.Equal("bundle", bundle.Type)
+	assert.Regexp(`^bundle--[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, bundle.ID)
+
+	var patterns []string
+	for _, o := range bundle.Objects {
+		assert.Equal("indicator", o["type"])
+		assert.Equal("2.1", o["spec_version"])
+		assert.Equal("stix", o["pattern_type"])
+		assert.Equal("2026-10-19T09:45:00.000Z", o["valid_from"])
+		patterns = append(patterns, o["pattern"].(string))
+	}
+	assert.Equal([]string{
+		"[url:value = 'https://evil.example.com/drop.exe']",
+		"[ipv4-addr:value = '192.168.1.1']",
+		"[ipv4-addr:value = '10.0.0.7']",
+		"[email-addr:value = 'admin@mail.ru']",
+		"[file:hashes.MD5 = 'd41d8cd98f00b204e9800998ecf8427e']",
+		"[file:hashes.'SHA-256' = 'e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855']",
+		`[url:value = 'http://x.com/it\'s']`,
+	}, patterns)
+	assert.Equal("indicator--619e94cb-bcb7-5492-8e3e-d934e7a8cf0a", bundle.Objects[1]["id"])
+
+	again, err := STIXBundle(iocs, created)
+	assert.NoError(err)
+	assert.Equal(string(data), string(again))
+
+	data, err = STIXBundle(nil, created)
+	assert.NoError(err)
+	assert.Contains(string(data), `"objects":[]`)
+}
+
+func TestUUID5(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	dns := [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	assert.Equal("2ed6657d-e927-568b-95e1-2665a8aea6a2", uuid5(dns, "www.example.com"))
+}
@@ -0,0 +1,232 @@
+package commonregex
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// JSONMatch is a match found in a string of a JSON document. Start and End
+// are byte offsets into the decoded string, not into the raw document.
+type JSONMatch struct {
+	Match
+	// Path is the JSONPath of the value the match was found in, or of the
+	// member whose key it was found in, like $.users[0].email
+	Path string
+	// Key is set when the match was found in an object key
+	Key bool
+	// Document is the index of the top-level value in a stream of JSON
+	// values
+	Document int
+}
+
+// JSONOptions selects what ScanJSON and RedactJSON look at
+type JSONOptions struct {
+	// Kinds are the kinds searched for. Every built-in kind is searched for
+	// if it is empty.
+	Kinds []Kind
+	// Keys makes object keys searched too. Only string values are searched
+	// otherwise.
+	Keys bool
+	// Replace returns the text a match is replaced with by RedactJSON. Matches
+	// are replaced with "[REDACTED]" if it is nil.
+	Replace func(JSONMatch) string
+}
+
+// ScanJSON decodes the stream of JSON values read from r and finds the
+// matches in their strings. Numbers, booleans and nulls are not searched.
+// The matches are returned in document order. If the input is not valid
+// JSON, the matches found before the error are returned along with it.
+func ScanJSON(r io.Reader, opts JSONOptions) ([]JSONMatch, error) {
+	var matches []JSONMatch
+	err := walkJSON(r, nil, opts, func(s string, m []JSONMatch) string {
+		matches = append(matches, m...)
+		return s
+	})
+	return matches, err
+}
+
+// RedactJSON copies the stream of JSON values read from r to w with the
+// matches in its strings replaced. The output is valid JSON with the same
+// structure as the input, written compactly with one top-level value per line.
+// Overlapping matches are replaced as one, using the first of them.
+func RedactJSON(w io.Writer, r io.Reader, opts JSONOptions) error {
+	replace := opts.Replace
+	if replace == nil {
+		replace = func(JSONMatch) string { return "[REDACTED]" }
+	}
+	return walkJSON(r, w, opts, func(s string, matches []JSONMatch) string {
+		if len(matches) == 0 {
+			return s
+		}
+		var b strings.Builder
+		last := 0
+		for i := 0; i < len(matches); {
+			m := matches[i]
+			end := m.End
+			for i++; i < len(matches) && matches[i].Start < end; i++ {
+				if matches[i].End > end {
+					end = matches[i].End
+				}
+			}
+			b.WriteString(s[last:m.Start])
+			b.WriteString(replace(m))
+			last = end
+		}
+		b.WriteString(s[last:])
+		return b.String()
+	})
+}
+
+// jsonFrame is an object or array the walker is inside of
+type jsonFrame struct {
+	object bool
+	// n is the number of members or elements seen so far
+	n int
+	// key is the key of the current member
+	key string
+}
+
+// walkJSON reads JSON tokens from r and calls visit with each string to be
+// searched and the matches in it. If w is not nil, the tokens are written to
+// it with strings replaced by what visit returns.
+func walkJSON(r io.Reader, w io.Writer, opts JSONOptions, visit func(string, []JSONMatch) string) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	out := jsonWriter{w: w}
+
+	var stack []jsonFrame
+	for doc := 0; ; {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return out.err
+		}
+		if err != nil {
+			return err
+		}
+
+		isKey := false
+		if len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if d, ok := tok.(json.Delim); !ok || (d != '}' && d != ']') {
+				if top.object {
+					// Object tokens alternate between keys and values.
+					isKey = top.n%2 == 0
+					if isKey {
+						top.key = tok.(string)
+					}
+				}
+				out.separate(top, isKey)
+				top.n++
+			}
+		}
+
+		switch tok := tok.(type) {
+		case json.Delim:
+			switch tok {
+			case '{', '[':
+				stack = append(stack, jsonFrame{object: tok == '{'})
+			default:
+				stack = stack[:len(stack)-1]
+			}
+			out.write(string(tok))
+		case string:
+			if !isKey || opts.Keys {
+				var found []JSONMatch
+				path := jsonPath(stack, isKey)
+				for _, m := range Scan(tok, opts.Kinds...) {
+					found = append(found, JSONMatch{Match: m, Path: path, Key: isKey, Document: doc})
+				}
+				tok = visit(tok, found)
+			}
+			out.writeString(tok)
+		case json.Number:
+			out.write(tok.String())
+		case bool:
+			out.write(strconv.FormatBool(tok))
+		case nil:
+			out.write("null")
+		}
+
+		if len(stack) == 0 {
+			out.write("\n")
+			doc++
+		}
+	}
+}
+
+// jsonPath returns the JSONPath of the current value, or of the member whose
+// key is current
+func jsonPath(stack []jsonFrame, isKey bool) string {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, f := range stack {
+		if !f.object {
+			fmt.Fprintf(&b, "[%d]", f.n-1)
+		} else if isIdentifier(f.key) {
+			b.WriteString(".")
+			b.WriteString(f.key)
+		} else {
+			b.WriteString("['")
+			b.WriteString(strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(f.key))
+			b.WriteString("']")
+		}
+	}
+	return b.String()
+}
+
+// isIdentifier reports whether key can be written in dot notation
+func isIdentifier(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i, c := range key {
+		switch {
+		case c == '_' || c == '$' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z':
+		case i > 0 && '0' <= c && c <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// jsonWriter writes JSON tokens compactly, keeping the first error. It
+// discards everything if w is nil.
+type jsonWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (o *jsonWriter) write(s string) {
+	if o.w == nil || o.err != nil {
+		return
+	}
+	_, o.err = io.WriteString(o.w, s)
+}
+
+// separate writes the comma or colon due before the next token of f
+func (o *jsonWriter) separate(f *jsonFrame, isKey bool) {
+	switch {
+	case f.object && !isKey:
+		o.write(":")
+	case f.n > 0:
+		o.write(",")
+	}
+}
+
+func (o *jsonWriter) writeString(s string) {
+	if o.w == nil {
+		return
+	}
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil && o.err == nil {
+		o.err = err
+	}
+	o.write(strings.TrimSuffix(b.String(), "\n"))
+}
@@ -0,0 +1,108 @@
+package commonregex
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testJSON = `{
+  "users": [
+    {"name": "John", "email": "john@example.com", "age": 42},
+    {"name": "Jane", "contact": {"emails": ["jane@example.com", "jane.doe@example.org"]}}
+  ],
+  "admin@example.com": true,
+  "note a": "ping 10.0.0.1 or <mail \"ops@example.com\">",
+  "count": 1.50e3,
+  "none": null
+}`
+
+func TestScanJSON(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	matches, err := ScanJSON(strings.NewReader(testJSON), JSONOptions{Kinds: []Kind{KindEmail, KindIPv4}})
+	assert.NoError(err)
+	assert.Equal([]JSONMatch{
+		{Match: Match{KindEmail, "john@example.com", 0, 16}, Path: "$.users[0].email"},
+		{Match: Match{KindEmail, "jane@example.com", 0, 16}, Path: "$.users[1].contact.emails[0]"},
+		{Match: Match{KindEmail, "jane.doe@example.org", 0, 20}, Path: "$.users[1].contact.emails[1]"},
+		{Match: Match{KindIPv4, "10.0.0.1", 5, 13}, Path: "$['note a']"},
+		{Match: Match{KindEmail, "ops@example.com", 24, 39}, Path: "$['note a']"},
+	}, matches)
+
+	matches, err = ScanJSON(strings.NewReader(testJSON), JSONOptions{Kinds: []Kind{KindEmail}, Keys: true})
+	assert.NoError(err)
+	assert.Len(matches, 5)
+	assert.Equal(JSONMatch{Match: Match{KindEmail, "admin@example.com", 0, 17}, Path: "$['admin@example.com']", Key: true}, matches[3])
+}
+
+func TestScanJSON_Stream(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	stream := `{"from": "a@example.com"}
+"b@example.com"
+[1, "c@example.com"]`
+	matches, err := ScanJSON(strings.NewReader(stream), JSONOptions{Kinds: []Kind{KindEmail}})
+	assert.NoError(err)
+	assert.Equal([]JSONMatch{
+		{Match: Match{KindEmail, "a@example.com", 0, 13}, Path: "$.from", Document: 0},
+		{Match: Match{KindEmail, "b@example.com", 0, 13}, Path: "$", Document: 1},
+		{Match: Match{KindEmail, "c@example.com", 0, 13}, Path: "$[1]", Document: 2},
+	}, matches)
+}
+
+func TestScanJSON_Invalid(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	matches, err := ScanJSON(strings.NewReader(`["a@example.com", }`), JSONOptions{Kinds: []Kind{KindEmail}})
+	assert.Error(err)
+	assert.Len(matches, 1)
+}
+
+func TestRedactJSON(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	var out bytes.Buffer
+	err := RedactJSON(&out, strings.NewReader(testJSON), JSONOptions{Kinds: []Kind{KindEmail, KindIPv4}, Keys: true})
+	assert.NoError(err)
+	assert.Equal(`{"users":[{"name":"John","email":"[REDACTED]","age":42},{"name":"Jane","contact":{"emails":["[REDACTED]","[REDACTED]"]}}],"[REDACTED]":true,"note a":"ping [REDACTED] or <mail \"[REDACTED]\">","count":1.50e3,"none":null}`+"\n", out.String())
+	assert.True(json.Valid(out.Bytes()))
+
+	out.Reset()
+	err = RedactJSON(&out, strings.NewReader(`"mail john@example.com" ["http://example.com/x"]`), JSONOptions{
+		Kinds:   []Kind{KindLink, KindEmail},
+		Replace: func(m JSONMatch) string { return "<" + string(m.Kind) + ">" },
+	})
+	assert.NoError(err)
+	assert.Equal("\"mail <email>\"\n[\"<link>\"]\n", out.String())
+}
+
+func TestJSONPath(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []struct {
+		stack []jsonFrame
+		isKey bool
+		path  string
+	}{
+		{nil, false, "$"},
+		{[]jsonFrame{{object: true, n: 2, key: "a"}}, false, "$.a"},
+		{[]jsonFrame{{object: true, n: 1, key: "a"}}, true, "$.a"},
+		{[]jsonFrame{{n: 3}, {object: true, n: 2, key: "_x1"}}, false, "$[2]._x1"},
+		{[]jsonFrame{{object: true, n: 2, key: "it's"}}, false, `$['it\'s']`},
+		{[]jsonFrame{{object: true, n: 2, key: "1st"}}, false, "$['1st']"},
+		{[]jsonFrame{{object: true, n: 2, key: ""}}, false, "$['']"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.path, jsonPath(test.stack, test.isKey))
+	}
+}
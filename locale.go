@@ -0,0 +1,68 @@
+package commonregex
+
+import "strings"
+
+// Locale is a language, or a language and the region it is written in, as a
+// BCP 47 tag
+type Locale string
+
+// Locales with conventions the finders know
+const (
+	LocaleEnglish    Locale = "en"
+	LocaleFrench     Locale = "fr"
+	LocaleGerman     Locale = "de"
+	LocaleSpanish    Locale = "es"
+	LocaleItalian    Locale = "it"
+	LocaleDutch      Locale = "nl"
+	LocalePortuguese Locale = "pt"
+	LocaleKorean     Locale = "ko"
+	LocaleJapanese   Locale = "ja"
+)
+
+// InLocale makes FindNumbers, FindPercentages, FindQuantities and the finders
+// built on them read numbers the way a locale writes them, like "1.234,5" for
+// German or "1 234,5" for French, and Date and ParseDates find the dates
+// written in it, like "23. März 2017". Without it, or with a locale they do
+// not know, they follow English conventions. Other finders ignore it.
+func InLocale(locale Locale) Option {
+	return func(o *options) {
+		o.locale = locale
+	}
+}
+
+// numberSeparators are how a locale groups thousands and marks the decimal
+// point, as regular expressions
+type numberSeparators struct {
+	group, point string
+}
+
+var localeNumberSeparators = map[Locale]numberSeparators{
+	LocaleEnglish:    {`,`, `\.`},
+	LocaleFrench:     {`[ \x{00A0}\x{202F}]`, `,`},
+	LocaleGerman:     {`\.`, `,`},
+	LocaleSpanish:    {`\.`, `,`},
+	LocaleItalian:    {`\.`, `,`},
+	LocaleDutch:      {`\.`, `,`},
+	LocalePortuguese: {`\.`, `,`},
+	LocaleKorean:     {`,`, `\.`},
+	LocaleJapanese:   {`,`, `\.`},
+}
+
+// language returns the language of the locale, "de" for "de-CH"
+func (l Locale) language() Locale {
+	for i := 0; i < len(l); i++ {
+		if l[i] == '-' || l[i] == '_' {
+			return Locale(strings.ToLower(string(l[:i])))
+		}
+	}
+	return Locale(strings.ToLower(string(l)))
+}
+
+// separators returns the number separators of the locale, or of English if
+// it is not known
+func (l Locale) separators() numberSeparators {
+	if seps, ok := localeNumberSeparators[l.language()]; ok {
+		return seps
+	}
+	return localeNumberSeparators[LocaleEnglish]
+}
@@ -0,0 +1,105 @@
+package commonregex
+
+// mappedText is text derived from a source, like the decoded text of an HTML
+// element, along with the source span each of its bytes came from. Matches
+// found in the text are mapped back to the source with span.
+type mappedText struct {
+	text         []byte
+	starts, ends []int
+}
+
+// newMappedText returns src mapped to itself
+func newMappedText(src string) *mappedText {
+	m := &mappedText{}
+	m.copy(src, 0, len(src))
+	return m
+}
+
+// copy appends src[from:to] unchanged
+func (m *mappedText) copy(src string, from, to int) {
+	for i := from; i < to; i++ {
+		m.text = append(m.text, src[i])
+		m.starts = append(m.starts, i)
+		m.ends = append(m.ends, i+1)
+	}
+}
+
+// replace appends s, which stands for the source span [from, to)
+func (m *mappedText) replace(s string, from, to int) {
+	for i := 0; i < len(s); i++ {
+		m.text = append(m.text, s[i])
+		m.starts = append(m.starts, from)
+		m.ends = append(m.ends, to)
+	}
+}
+
+func (m *mappedText) len() int {
+	return len(m.text)
+}
+
+func (m *mappedText) String() string {
+	return string(m.text)
+}
+
+// span returns the source span of text[start:end]
+func (m *mappedText) span(start, end int) (int, int) {
+	if start >= end {
+		if start < len(m.starts) {
+			return m.starts[start], m.starts[start]
+		}
+		if start > 0 {
+			return m.ends[start-1], m.ends[start-1]
+		}
+		return 0, 0
+	}
+	return m.starts[start], m.ends[end-1]
+}
+
+// edit replaces text[from:to] with s
+type edit struct {
+	from, to int
+	s        string
+}
+
+// rewrite returns the text with the edits applied, mapped to the source of m.
+// The edits must be sorted and must not overlap.
+func (m *mappedText) rewrite(edits []edit) *mappedText {
+	out := &mappedText{}
+	last := 0
+	for _, e := range edits {
+		out.extend(m, last, e.from)
+		from, to := m.span(e.from, e.to)
+		out.replace(e.s, from, to)
+		last = e.to
+	}
+	out.extend(m, last, len(m.text))
+	return out
+}
+
+// extend appends src.text[from:to] with its mapping
+func (m *mappedText) extend(src *mappedText, from, to int) {
+	m.text = append(m.text, src.text[from:to]...)
+	m.starts = append(m.starts, src.starts[from:to]...)
+	m.ends = append(m.ends, src.ends[from:to]...)
+}
+
+// scan finds the matches of the kinds in the text, with their values taken
+// from the text and their offsets mapped to the source
+func (m *mappedText) scan(kinds []Kind) []Match {
+	matches := Scan(m.String(), kinds...)
+	for i := range matches {
+		matches[i].Start, matches[i].End = m.span(matches[i].Start, matches[i].End)
+	}
+	return matches
+}
+
+// scanMapped scans each text and returns the matches of all of them in
+// source order
+func scanMapped(texts []*mappedText, kinds []Kind) []Match {
+	var matches []Match
+	for _, t := range texts {
+		matches = append(matches, t.scan(kinds)...)
+	}
+	sortMatches(matches)
+	return matches
+}
@@ -0,0 +1,471 @@
+package commonregex
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// MarkupOptions selects what ScanHTML and ScanMarkdown look at
+type MarkupOptions struct {
+	// Kinds are the kinds searched for. Every built-in kind is searched for
+	// if it is empty.
+	Kinds []Kind
+	// SkipCode leaves out code: code and pre elements in HTML, and code
+	// spans and fenced code blocks in Markdown
+	SkipCode bool
+}
+
+// urlAttributes are the HTML attributes whose values ScanHTML searches
+var urlAttributes = map[string]bool{
+	"href":   true,
+	"src":    true,
+	"srcset": true,
+	"action": true,
+	"cite":   true,
+	"poster": true,
+}
+
+// inlineElements are the HTML elements whose tags don't end a run of text
+var inlineElements = map[string]bool{
+	"a": true, "abbr": true, "b": true, "bdi": true, "bdo": true, "cite": true,
+	"code": true, "data": true, "dfn": true, "em": true, "font": true,
+	"i": true, "kbd": true, "mark": true, "q": true, "s": true, "samp": true,
+	"small": true, "span": true, "strong": true, "sub": true, "sup": true,
+	"time": true, "u": true, "var": true, "wbr": true,
+}
+
+// ScanHTML finds the matches in the text and the link attributes (href, src,
+// srcset, action, cite and poster) of an HTML document. Character references
+// are decoded before searching, so Value holds the decoded match while Start
+// and End are the offsets of its source in src. Comments, scripts and style
+// sheets are skipped. Text interrupted by tags other than inline ones like
+// <b> or <span> is searched in separate runs.
+func ScanHTML(src string, opts MarkupOptions) []Match {
+	return scanMapped(htmlTexts(src, opts.SkipCode), opts.Kinds)
+}
+
+// htmlTexts returns the runs of text and the link attribute values of src
+func htmlTexts(src string, skipCode bool) []*mappedText {
+	var texts []*mappedText
+	text := &mappedText{}
+	flush := func() {
+		if text.len() > 0 {
+			texts = append(texts, text)
+			text = &mappedText{}
+		}
+	}
+
+	for i := 0; i < len(src); {
+		j := strings.IndexByte(src[i:], '<')
+		if j < 0 {
+			decodeHTML(text, src, i, len(src))
+			break
+		}
+		decodeHTML(text, src, i, i+j)
+		i += j
+
+		rest := src[i:]
+		switch {
+		case strings.HasPrefix(rest, "<!--"):
+			flush()
+			i = skipPast(src, i+4, "-->")
+		case len(rest) > 1 && (rest[1] == '!' || rest[1] == '?'):
+			flush()
+			i = skipPast(src, i+2, ">")
+		case len(rest) > 1 && isASCIILetter(rest[1]) || len(rest) > 2 && rest[1] == '/' && isASCIILetter(rest[2]):
+			tag := parseTag(src, i)
+			if !inlineElements[tag.name] {
+				flush()
+			}
+			for _, attr := range tag.attrs {
+				if urlAttributes[attr.name] {
+					value := &mappedText{}
+					decodeHTML(value, src, attr.start, attr.end)
+					texts = append(texts, value)
+				}
+			}
+			i = tag.end
+			if !tag.closing && (tag.name == "script" || tag.name == "style" ||
+				skipCode && (tag.name == "code" || tag.name == "pre")) {
+				flush()
+				if k := indexFold(src[i:], "</"+tag.name); k >= 0 {
+					i += k
+				} else {
+					i = len(src)
+				}
+			}
+		default:
+			text.copy(src, i, i+1)
+			i++
+		}
+	}
+	flush()
+	return texts
+}
+
+// htmlTag is a start or end tag. The spans of attribute values exclude their
+// quotes.
+type htmlTag struct {
+	name    string
+	closing bool
+	attrs   []htmlAttribute
+	end     int
+}
+
+type htmlAttribute struct {
+	name       string
+	start, end int
+}
+
+// parseTag parses the tag starting at src[i], which is '<'
+func parseTag(src string, i int) htmlTag {
+	var tag htmlTag
+	i++
+	if src[i] == '/' {
+		tag.closing = true
+		i++
+	}
+	start := i
+	for i < len(src) && !isHTMLSpace(src[i]) && src[i] != '>' && src[i] != '/' {
+		i++
+	}
+	tag.name = strings.ToLower(src[start:i])
+
+	for i < len(src) {
+		for i < len(src) && (isHTMLSpace(src[i]) || src[i] == '/') {
+			i++
+		}
+		if i == len(src) || src[i] == '>' {
+			break
+		}
+		start := i
+		for i < len(src) && !isHTMLSpace(src[i]) && src[i] != '=' && src[i] != '>' && src[i] != '/' {
+			i++
+		}
+		attr := htmlAttribute{name: strings.ToLower(src[start:i]), start: i, end: i}
+		for i < len(src) && isHTMLSpace(src[i]) {
+			i++
+		}
+		if i < len(src) && src[i] == '=' {
+			i++
+			for i < len(src) && isHTMLSpace(src[i]) {
+				i++
+			}
+			if i < len(src) && (src[i] == '"' || src[i] == '\'') {
+				quote := src[i]
+				i++
+				attr.start = i
+				for i < len(src) && src[i] != quote {
+					i++
+				}
+				attr.end = i
+				if i < len(src) {
+					i++
+				}
+			} else {
+				attr.start = i
+				for i < len(src) && !isHTMLSpace(src[i]) && src[i] != '>' {
+					i++
+				}
+				attr.end = i
+			}
+		}
+		if attr.name != "" {
+			tag.attrs = append(tag.attrs, attr)
+		}
+	}
+	if i < len(src) {
+		i++
+	}
+	tag.end = i
+	return tag
+}
+
+// decodeHTML appends src[from:to] to t with character references decoded
+func decodeHTML(t *mappedText, src string, from, to int) {
+	for i := from; i < to; {
+		if src[i] == '&' {
+			if decoded, end, ok := decodeEntity(src[:to], i); ok {
+				t.replace(decoded, i, end)
+				i = end
+				continue
+			}
+		}
+		t.copy(src, i, i+1)
+		i++
+	}
+}
+
+// maxEntityLength is the length of the longest character reference decoded,
+// like "&CounterClockwiseContourIntegral;"
+const maxEntityLength = 33
+
+// decodeEntity decodes the character reference at src[i], which is '&', and
+// returns it with the offset it ends at. References must end with ';'.
+func decodeEntity(src string, i int) (string, int, bool) {
+	rest := src[i:]
+	if len(rest) > maxEntityLength {
+		rest = rest[:maxEntityLength]
+	}
+	j := strings.IndexByte(rest, ';')
+	if j < 2 {
+		return "", 0, false
+	}
+	ref := rest[:j+1]
+	decoded := html.UnescapeString(ref)
+	if decoded == ref {
+		return "", 0, false
+	}
+	return decoded, i + j + 1, true
+}
+
+// ScanMarkdown finds the matches in the text, link destinations and autolinks
+// of a Markdown document. Backslash escapes and character references are
+// decoded before searching, so Value holds the decoded match while Start and
+// End are the offsets of its source in src. Link destinations and autolinks
+// are searched apart from the surrounding text, and so are code spans and
+// fenced code blocks unless opts.SkipCode leaves them out.
+func ScanMarkdown(src string, opts MarkupOptions) []Match {
+	return scanMapped(markdownTexts(src, opts.SkipCode), opts.Kinds)
+}
+
+var (
+	// fenceRegex matches the opening line of a fenced code block
+	fenceRegex = regexp.MustCompile("\\A {0,3}(`{3,}|~{3,})[^\n]*\n?")
+	// linkDefinitionRegex matches a link reference definition, capturing its
+	// destination
+	linkDefinitionRegex = regexp.MustCompile(`\A {0,3}\[[^\]\n]+\]:[ \t]*(?:<([^>\n]*)>|(\S+))[^\n]*`)
+	// autolinkRegex matches an autolink, capturing the URI or email address
+	autolinkRegex = regexp.MustCompile(`\A<([a-zA-Z][a-zA-Z0-9+.-]{1,31}:[^\s<>]*|[a-zA-Z0-9.!#$%&'*+/=?^_` + "`" + `{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9.-]*[a-zA-Z0-9])?)>`)
+)
+
+// markdownTexts returns the runs of text, the link destinations and the code
+// of src
+func markdownTexts(src string, skipCode bool) []*mappedText {
+	var texts []*mappedText
+	text := &mappedText{}
+	flush := func() {
+		if text.len() > 0 {
+			texts = append(texts, text)
+			text = &mappedText{}
+		}
+	}
+	// emit adds src[from:to] as a text of its own
+	emit := func(from, to int, decode bool) {
+		t := &mappedText{}
+		if decode {
+			decodeMarkdown(t, src, from, to)
+		} else {
+			t.copy(src, from, to)
+		}
+		if t.len() > 0 {
+			texts = append(texts, t)
+		}
+	}
+
+	for i := 0; i < len(src); {
+		if i == 0 || src[i-1] == '\n' {
+			if loc := fenceRegex.FindStringSubmatchIndex(src[i:]); loc != nil {
+				flush()
+				fence := src[i+loc[2] : i+loc[3]]
+				start := i + loc[1]
+				end, next := closingFence(src, start, fence)
+				if !skipCode {
+					emit(start, end, false)
+				}
+				i = next
+				continue
+			}
+			if loc := linkDefinitionRegex.FindStringSubmatchIndex(src[i:]); loc != nil {
+				flush()
+				if loc[2] >= 0 {
+					emit(i+loc[2], i+loc[3], true)
+				} else {
+					emit(i+loc[4], i+loc[5], true)
+				}
+				i += loc[1]
+				continue
+			}
+		}
+
+		switch c := src[i]; {
+		case c == '\\' && i+1 < len(src) && isASCIIPunct(src[i+1]):
+			text.replace(src[i+1:i+2], i, i+2)
+			i += 2
+		case c == '&':
+			if decoded, end, ok := decodeEntity(src, i); ok {
+				text.replace(decoded, i, end)
+				i = end
+			} else {
+				text.copy(src, i, i+1)
+				i++
+			}
+		case c == '`':
+			n := 1
+			for i+n < len(src) && src[i+n] == '`' {
+				n++
+			}
+			end := closingBackticks(src, i+n, n)
+			if end < 0 {
+				text.copy(src, i, i+n)
+				i += n
+				continue
+			}
+			flush()
+			if !skipCode {
+				emit(i+n, end, false)
+			}
+			i = end + n
+		case c == '<':
+			if loc := autolinkRegex.FindStringSubmatchIndex(src[i:]); loc != nil {
+				flush()
+				emit(i+loc[2], i+loc[3], false)
+				i += loc[1]
+				continue
+			}
+			text.copy(src, i, i+1)
+			i++
+		case c == ']' && i+1 < len(src) && src[i+1] == '(':
+			text.copy(src, i, i+1)
+			flush()
+			start, end, next := linkDestination(src, i+2)
+			emit(start, end, true)
+			i = next
+		default:
+			text.copy(src, i, i+1)
+			i++
+		}
+	}
+	flush()
+	return texts
+}
+
+// closingFence finds the line closing a code block opened by fence, returning
+// where the code ends and where the line after the closing fence starts. An
+// unclosed block runs to the end of src.
+func closingFence(src string, start int, fence string) (int, int) {
+	for i := start; i < len(src); {
+		line := src[i:]
+		if j := strings.IndexByte(line, '\n'); j >= 0 {
+			line = line[:j+1]
+		}
+		trimmed := strings.TrimLeft(line, " ")
+		if len(line)-len(trimmed) <= 3 && strings.HasPrefix(trimmed, fence) &&
+			strings.TrimSpace(strings.TrimLeft(trimmed, fence[:1])) == "" {
+			return i, i + len(line)
+		}
+		i += len(line)
+	}
+	return len(src), len(src)
+}
+
+// closingBackticks returns the offset of the run of exactly n backticks
+// closing a code span whose content starts at src[from], or -1
+func closingBackticks(src string, from, n int) int {
+	for i := from; i < len(src); {
+		if src[i] != '`' {
+			i++
+			continue
+		}
+		j := i
+		for j < len(src) && src[j] == '`' {
+			j++
+		}
+		if j-i == n {
+			return i
+		}
+		i = j
+	}
+	return -1
+}
+
+// linkDestination parses the destination of an inline link from src[from],
+// just after "](". It returns the span of the destination and the offset
+// after the closing parenthesis.
+func linkDestination(src string, from int) (int, int, int) {
+	i := from
+	for i < len(src) && (src[i] == ' ' || src[i] == '\t' || src[i] == '\n') {
+		i++
+	}
+	var start, end int
+	if i < len(src) && src[i] == '<' {
+		start = i + 1
+		end = start
+		for end < len(src) && src[end] != '>' && src[end] != '\n' {
+			end++
+		}
+		i = end
+	} else {
+		start = i
+		depth := 0
+		for i < len(src) && src[i] > ' ' {
+			if src[i] == '(' {
+				depth++
+			} else if src[i] == ')' {
+				if depth == 0 {
+					break
+				}
+				depth--
+			}
+			i++
+		}
+		end = i
+	}
+	if j := strings.IndexByte(src[i:], ')'); j >= 0 {
+		return start, end, i + j + 1
+	}
+	return start, end, end
+}
+
+// decodeMarkdown appends src[from:to] to t with backslash escapes and
+// character references decoded
+func decodeMarkdown(t *mappedText, src string, from, to int) {
+	for i := from; i < to; {
+		if src[i] == '\\' && i+1 < to && isASCIIPunct(src[i+1]) {
+			t.replace(src[i+1:i+2], i, i+2)
+			i += 2
+			continue
+		}
+		if src[i] == '&' {
+			if decoded, end, ok := decodeEntity(src[:to], i); ok {
+				t.replace(decoded, i, end)
+				i = end
+				continue
+			}
+		}
+		t.copy(src, i, i+1)
+		i++
+	}
+}
+
+// skipPast returns the offset after the first sep in src from i, or the end
+// of src
+func skipPast(src string, i int, sep string) int {
+	if j := strings.Index(src[i:], sep); j >= 0 {
+		return i + j + len(sep)
+	}
+	return len(src)
+}
+
+// indexFold is strings.Index ignoring ASCII case
+func indexFold(s, substr string) int {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len(substr)], substr) {
+			return i
+		}
+	}
+	return -1
+}
+
+func isASCIILetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isASCIIPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+func isHTMLSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
@@ -0,0 +1,142 @@
+package commonregex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanHTML(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	src := `<html><head><style>a { color: #fff }</style><script>var ip = "10.0.0.1";</script></head>
+<body>
+<!-- old: admin@example.com -->
+<p>Write to john&#64;example.com or <a href="mailto:jane@example.org">Jane</a>.</p>
+<p>Call <b>555</b>-123-4567 <img src='http://cdn.example.com/a.png'></p>
+<pre>root@localhost.com</pre>
+</body></html>`
+
+	matches := ScanHTML(src, MarkupOptions{Kinds: []Kind{KindEmail, KindLink, KindIPv4}})
+	var values []string
+	for _, m := range matches {
+		values = append(values, m.Value)
+	}
+	assert.Equal([]string{
+		"john@example.com",
+		"example.com",
+		"jane@example.org",
+		"example.org",
+		"http://cdn.example.com/a.png",
+		"root@localhost.com",
+		"localhost.com",
+	}, values)
+
+	john := matches[0]
+	assert.Equal("john&#64;example.com", src[john.Start:john.End])
+	jane := matches[2]
+	assert.Equal("jane@example.org", src[jane.Start:jane.End])
+
+	matches = ScanHTML(src, MarkupOptions{Kinds: []Kind{KindEmail}, SkipCode: true})
+	assert.Len(matches, 2)
+
+	matches = ScanHTML(src, MarkupOptions{Kinds: []Kind{KindPhone}})
+	assert.Len(matches, 1)
+	assert.Equal("555</b>-123-4567", src[matches[0].Start:matches[0].End])
+}
+
+func TestParseTag(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	src := `<A HREF = "x y" data-x=1 checked src=a.png/>rest`
+	tag := parseTag(src, 0)
+	assert.Equal("a", tag.name)
+	assert.False(tag.closing)
+	assert.Equal(len(src)-len("rest"), tag.end)
+	var attrs []string
+	for _, attr := range tag.attrs {
+		attrs = append(attrs, attr.name+"="+src[attr.start:attr.end])
+	}
+	assert.Equal([]string{"href=x y", "data-x=1", "checked=", "src=a.png/"}, attrs)
+
+	tag = parseTag("</P >", 0)
+	assert.Equal("p", tag.name)
+	assert.True(tag.closing)
+	assert.Equal(5, tag.end)
+
+	tag = parseTag(`<a href="unterminated`, 0)
+	assert.Equal(len(`<a href="unterminated`), tag.end)
+}
+
+func TestScanMarkdown(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	src := "# Contact\n" +
+		"Mail john\\_doe@example.com or [Jane](mailto:jane@example.org \"Jane\").\n" +
+		"See <https://example.com/docs> and <ops@example.net>.\n" +
+		"Run `curl 10.0.0.1` first.\n" +
+		"\n" +
+		"```sh\n" +
+		"ssh root@10.0.0.2\n" +
+		"```\n" +
+		"[ref]: <http://example.com/ref> \"Ref\"\n"
+
+	matches := ScanMarkdown(src, MarkupOptions{Kinds: []Kind{KindEmail, KindIPv4}})
+	var values []string
+	for _, m := range matches {
+		values = append(values, m.Value)
+	}
+	assert.Equal([]string{
+		"john_doe@example.com",
+		"jane@example.org",
+		"ops@example.net",
+		"10.0.0.1",
+		"root@10.0.0.2",
+		"10.0.0.2",
+	}, values)
+	assert.Equal(`john\_doe@example.com`, src[matches[0].Start:matches[0].End])
+	assert.Equal("ops@example.net", src[matches[2].Start:matches[2].End])
+
+	matches = ScanMarkdown(src, MarkupOptions{Kinds: []Kind{KindEmail, KindIPv4}, SkipCode: true})
+	assert.Len(matches, 3)
+
+	matches = ScanMarkdown(src, MarkupOptions{Kinds: []Kind{KindLink}})
+	values = nil
+	for _, m := range matches {
+		values = append(values, m.Value)
+	}
+	assert.Contains(values, "https://example.com/docs")
+	assert.Contains(values, "http://example.com/ref")
+}
+
+func TestMappedText(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	src := "a&amp;b"
+	var m mappedText
+	m.copy(src, 0, 1)
+	m.replace("&", 1, 6)
+	m.copy(src, 6, 7)
+	assert.Equal("a&b", m.String())
+
+	tests := []struct {
+		start, end       int
+		srcStart, srcEnd int
+	}{
+		{0, 3, 0, 7},
+		{1, 2, 1, 6},
+		{2, 3, 6, 7},
+		{1, 1, 1, 1},
+		{3, 3, 7, 7},
+	}
+
+	for _, test := range tests {
+		start, end := m.span(test.start, test.end)
+		assert.Equal(test.srcStart, start)
+		assert.Equal(test.srcEnd, end)
+	}
+}
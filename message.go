@@ -0,0 +1,166 @@
+package commonregex
+
+import (
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// MessageMatch is a match found in an email message. Start and End are byte
+// offsets into the decoded header value or part body.
+type MessageMatch struct {
+	Match
+	// Header is the name of the header the match was found in, or empty if
+	// it was found in a body
+	Header string
+	// Part is the number of the MIME part the match was found in, numbered
+	// as in IMAP: "1" for the body of a message that is not multipart, "2.1"
+	// for the first part of the second part of a multipart message. Matches
+	// in the headers of the message have an empty Part, and those of a
+	// message attached as a part have the number of that part.
+	Part string
+	// ContentType is the media type of the part, like "text/plain", or empty
+	// for a header
+	ContentType string
+}
+
+// ScanMessage reads an RFC 5322 message from r and finds the matches of the
+// given kinds, or of every built-in kind if none are given, in its headers and
+// text parts. Encoded words in headers and quoted-printable or base64 bodies
+// are decoded, and so are ISO-8859-1 bodies. HTML parts are searched like
+// ScanHTML does. Parts which are not text, and are not multipart or attached
+// messages, are skipped.
+//
+// The matches of the headers come first, in header name order, followed by
+// those of the parts. If the message is malformed, the matches found before
+// the error are returned along with it.
+func ScanMessage(r io.Reader, kinds ...Kind) ([]MessageMatch, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, err
+	}
+	s := messageScanner{kinds: kinds}
+	s.headers(textproto.MIMEHeader(msg.Header), "")
+	err = s.entity(textproto.MIMEHeader(msg.Header), msg.Body, "")
+	return s.matches, err
+}
+
+type messageScanner struct {
+	kinds   []Kind
+	matches []MessageMatch
+}
+
+// headers scans the decoded values of the headers of the message at part
+func (s *messageScanner) headers(h textproto.MIMEHeader, part string) {
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var dec mime.WordDecoder
+	for _, name := range names {
+		for _, value := range h[name] {
+			if decoded, err := dec.DecodeHeader(value); err == nil {
+				value = decoded
+			}
+			for _, m := range Scan(value, s.kinds...) {
+				s.matches = append(s.matches, MessageMatch{Match: m, Header: name, Part: part})
+			}
+		}
+	}
+}
+
+// entity scans the body of a message or MIME part with the header h
+func (s *messageScanner) entity(h textproto.MIMEHeader, r io.Reader, part string) error {
+	mediaType, params, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil {
+		mediaType = "text/plain"
+	}
+	body := transferDecoder(h.Get("Content-Transfer-Encoding"), r)
+
+	switch {
+	case strings.HasPrefix(mediaType, "multipart/"):
+		mr := multipart.NewReader(body, params["boundary"])
+		for i := 1; ; i++ {
+			p, err := mr.NextPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			child := strconv.Itoa(i)
+			if part != "" {
+				child = part + "." + child
+			}
+			if err := s.entity(p.Header, p, child); err != nil {
+				return err
+			}
+		}
+	case mediaType == "message/rfc822":
+		msg, err := mail.ReadMessage(body)
+		if err != nil {
+			return err
+		}
+		s.headers(textproto.MIMEHeader(msg.Header), part)
+		return s.entity(textproto.MIMEHeader(msg.Header), msg.Body, part)
+	case strings.HasPrefix(mediaType, "text/"):
+		b, err := io.ReadAll(body)
+		if err != nil {
+			return err
+		}
+		text := decodeCharset(b, params["charset"])
+		if part == "" {
+			part = "1"
+		}
+		var found []Match
+		if mediaType == "text/html" {
+			found = ScanHTML(text, MarkupOptions{Kinds: s.kinds})
+		} else {
+			found = Scan(text, s.kinds...)
+		}
+		for _, m := range found {
+			s.matches = append(s.matches, MessageMatch{Match: m, Part: part, ContentType: mediaType})
+		}
+	}
+	return nil
+}
+
+// transferDecoder decodes a body with the given Content-Transfer-Encoding
+func transferDecoder(encoding string, r io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "quoted-printable":
+		return quotedprintable.NewReader(r)
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, r)
+	}
+	return r
+}
+
+// decodeCharset converts an ISO-8859-1 text to UTF-8. Texts in other
+// charsets are returned as they are.
+func decodeCharset(b []byte, charset string) string {
+	switch strings.ToLower(charset) {
+	case "iso-8859-1", "latin1":
+		var sb strings.Builder
+		sb.Grow(len(b))
+		for _, c := range b {
+			if c < utf8.RuneSelf {
+				sb.WriteByte(c)
+			} else {
+				sb.WriteRune(rune(c))
+			}
+		}
+		return sb.String()
+	}
+	return string(b)
+}
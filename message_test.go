@@ -0,0 +1,75 @@
+package commonregex
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanMessage(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	f, err := os.Open("testdata/message.eml")
+	assert.NoError(err)
+	defer f.Close()
+
+	matches, err := ScanMessage(f, KindEmail, KindIBAN, KindIPv4, KindLink)
+	assert.NoError(err)
+
+	var hits []string
+	for _, m := range matches {
+		if m.Kind == KindLink && m.Part != "1.1" {
+			continue
+		}
+		hits = append(hits, strings.Join([]string{string(m.Kind), m.Value, m.Header, m.Part, m.ContentType}, " | "))
+	}
+	assert.Equal([]string{
+		"email | john@example.com | From |  | ",
+		"email | jane@example.net | Subject |  | ",
+		"email | ops@example.org | To |  | ",
+		"link | https://example.com/a-very-long-path/index.html |  | 1.1 | text/plain",
+		"email | john@example.com |  | 1.2 | text/html",
+		"email | john@example.com |  | 1.2 | text/html",
+		"iban | ES9121000418450200051332 |  | 2 | text/plain",
+		"email | legal@example.com | From | 4 | ",
+		"ipv4 | 10.0.0.1 |  | 4 | text/plain",
+	}, hits)
+}
+
+func TestScanMessage_SinglePart(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	msg := "From: john@example.com\r\n" +
+		"Content-Type: text/plain; charset=iso-8859-1\r\n" +
+		"\r\n" +
+		"Caf\xe9 at 555-123-4567\r\n"
+	matches, err := ScanMessage(strings.NewReader(msg), KindPhone)
+	assert.NoError(err)
+	assert.Equal([]MessageMatch{
+		{Match: Match{KindPhone, "555-123-4567", 9, 21}, Part: "1", ContentType: "text/plain"},
+	}, matches)
+}
+
+func TestScanMessage_Malformed(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	_, err := ScanMessage(strings.NewReader("not a header line\n"))
+	assert.Error(err)
+
+	msg := "Subject: 10.0.0.1\r\n" +
+		"Content-Type: multipart/mixed; boundary=b\r\n" +
+		"\r\n" +
+		"--b\r\n" +
+		"\r\n" +
+		"10.0.0.2\r\n" +
+		"--b\r\n" +
+		"broken"
+	matches, err := ScanMessage(strings.NewReader(msg), KindIPv4)
+	assert.Error(err)
+	assert.Len(matches, 2)
+}
@@ -0,0 +1,445 @@
+package commonregex
+
+import (
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// The forms of the amounts PricePattern matches, bare or in brackets or
+// after a "-". priceAmount is a number with "," or "." thousands separators
+// and an optional decimal part or multiplier.
+const (
+	priceCodes      = `(?:USD|EUR|GBP|JPY|CNY|INR|KRW|CAD|AUD|NZD|HKD|CHF|SEK|NOK|DKK|PLN|BRL|MXN|ZAR|SGD|RUB|TRY)`
+	priceMultiplier = `(?:[kKM]|bn|mn)?`
+	priceAmount     = `(?:\d{1,3}(?:,\d{3})+(?:\.\d{1,2})?|\d{1,3}(?:\.\d{3})+(?:,\d{1,2})?|\d+(?:[.,]\d{1,2})?)` + priceMultiplier
+	priceRupees     = `\d{1,2}(?:,\d{2})*,\d{3}(?:\.\d{1,2})?` + priceMultiplier
+
+	// priceDollars is a dollar amount, like "$1,200.50" or "US$5"
+	priceDollars = `(?:\bUS)?\$\s?[+-]?(?:\d{1,3}(?:,\d{3})+(?:\.\d{1,2})?|\d+(?:\.\d{1,2})?)` + priceMultiplier + `\b`
+	// priceRupeesBefore is a rupee amount in Indian grouping, like "₹12,34,567"
+	priceRupeesBefore = `(?:₹|\bINR)\s?[+-]?` + priceRupees + `\b`
+	// priceCurrencyBefore is an amount after a currency symbol or code, like
+	// "€1.200,50" or "USD 1,200"
+	priceCurrencyBefore = `(?:\b(?:C|A|NZ|HK|R)\$|[€£¥₹₩]|\b` + priceCodes + `)\s?[+-]?` + priceAmount + `\b`
+	// priceRupeesAfter is a rupee amount in Indian grouping before the
+	// currency, like "12,34,567 INR"
+	priceRupeesAfter = `\b` + priceRupees + `\b\s?(?:₹|INR\b)`
+	// priceSymbolAfter is an amount before a currency symbol, like "5 €"
+	priceSymbolAfter = `\b` + priceAmount + `\b\s?[€£¥₹₩]`
+	// priceCodeAfter is an amount before a currency code, like "1.200,50 EUR"
+	priceCodeAfter = `\b` + priceAmount + `\b\s?` + priceCodes + `\b`
+
+	priceForms = priceDollars + `|` + priceRupeesBefore + `|` + priceCurrencyBefore + `|` +
+		priceRupeesAfter + `|` + priceSymbolAfter + `|` + priceCodeAfter
+)
+
+// Decimal is an exact decimal number, written with a "-" if it is negative
+// and a "." before the fraction if it has one, like "-1200.50". Amounts are
+// kept as written rather than as floats, so that no cent is lost to
+// rounding.
+type Decimal string
+
+// Rat returns the decimal as a rational number
+func (d Decimal) Rat() *big.Rat {
+	r, _ := new(big.Rat).SetString(string(d))
+	return r
+}
+
+// currencySymbols maps the currency symbols PricePattern knows to their ISO
+// 4217 codes, those which are prefixes of others first. A bare "$" is taken
+// to be US dollars and "¥" Japanese yen.
+var currencySymbols = []struct {
+	symbol, code string
+}{
+	{"US$", "USD"},
+	{"NZ$", "NZD"},
+	{"HK$", "HKD"},
+	{"C$", "CAD"},
+	{"A$", "AUD"},
+	{"R$", "BRL"},
+	{"$", "USD"},
+	{"€", "EUR"},
+	{"£", "GBP"},
+	{"¥", "JPY"},
+	{"₹", "INR"},
+	{"₩", "KRW"},
+}
+
+// currencyCodes are the ISO 4217 codes PricePattern knows
+var currencyCodes = []string{
+	"USD", "EUR", "GBP", "JPY", "CNY", "INR", "KRW", "CAD", "AUD", "NZD", "HKD",
+	"CHF", "SEK", "NOK", "DKK", "PLN", "BRL", "MXN", "ZAR", "SGD", "RUB", "TRY",
+}
+
+// amountMultipliers are the shorthands for thousands, millions and billions,
+// with the number of places they move the decimal point
+var amountMultipliers = []struct {
+	suffix string
+	places int
+}{
+	{"bn", 9},
+	{"mn", 6},
+	{"M", 6},
+	{"k", 3},
+	{"K", 3},
+}
+
+// Money is an amount of money found by FindMoney or parsed by ParseMoney
+type Money struct {
+	// Value is the amount as written, like "(€1.200,50)"
+	Value string
+	// Currency is the ISO 4217 code of the currency, like "EUR"
+	Currency string
+	// Amount is the exact amount, like "-1200.50"
+	Amount Decimal
+	// Start and End are the offsets of the amount in the text searched, zero
+	// for ParseMoney
+	Start, End int
+}
+
+// ParseMoney reads an amount written as PricePattern matches it, like
+// "USD 1,200", "1.200,50 EUR", "-£5.99", "(¥1,000)" or "$2.5M". A "," or "."
+// followed by one or two digits at the end of the number is its decimal
+// point; otherwise both separate thousands. It reports false if s is not
+// entirely such an amount.
+func ParseMoney(s string) (Money, bool) {
+	if loc := PriceRegex.FindStringIndex(s); loc == nil || loc[0] != 0 || loc[1] != len(s) {
+		return Money{}, false
+	}
+
+	m := Money{Value: s}
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative = true
+		s = s[1 : len(s)-1]
+	}
+	if strings.HasPrefix(s, "-") {
+		negative = !negative
+		s = s[1:]
+	}
+
+	if m.Currency, s = trimCurrency(s, strings.HasPrefix, strings.TrimPrefix); m.Currency != "" {
+		s = strings.TrimLeft(s, " \t\r\n\f\v")
+		if strings.HasPrefix(s, "-") {
+			negative = !negative
+		}
+		s = strings.TrimLeft(s, "+-")
+	} else {
+		m.Currency, s = trimCurrency(s, strings.HasSuffix, strings.TrimSuffix)
+		s = strings.TrimRight(s, " \t\r\n\f\v")
+	}
+
+	places := 0
+	for _, mult := range amountMultipliers {
+		if strings.HasSuffix(s, mult.suffix) {
+			places = mult.places
+			s = strings.TrimSuffix(s, mult.suffix)
+			break
+		}
+	}
+	m.Amount = parseAmount(s, negative, places)
+	return m, true
+}
+
+// trimCurrency finds the currency symbol or code s starts or ends with, as
+// has tells, and returns its ISO 4217 code and s without it
+func trimCurrency(s string, has func(string, string) bool, trim func(string, string) string) (string, string) {
+	for _, c := range currencySymbols {
+		if has(s, c.symbol) {
+			return c.code, trim(s, c.symbol)
+		}
+	}
+	for _, code := range currencyCodes {
+		if has(s, code) {
+			return code, trim(s, code)
+		}
+	}
+	return "", s
+}
+
+// wholeAmount reports whether the amount text[start:end] is not cut from a
+// longer number, as PricePattern would cut "$1,00" from "$1,00,000": it must
+// not start after or end before a "," or "." and a digit
+func wholeAmount(text string, start, end int) bool {
+	separated := func(sep, digit byte) bool {
+		return (sep == ',' || sep == '.') && '0' <= digit && digit <= '9'
+	}
+	return !(start >= 2 && separated(text[start-1], text[start-2]) ||
+		end+1 < len(text) && separated(text[end], text[end+1]))
+}
+
+// The parts of PricePattern a price starting with a given byte can match,
+// keeping the order of its alternatives. Each is short enough for regexp to
+// run it by backtracking rather than on its slower automaton. They are only
+// tried where a price can start, so the word boundary at their start holds.
+var (
+	priceInBrackets     = regexp.MustCompile(`^\((?:` + priceForms + `)\)`)
+	priceNegative       = regexp.MustCompile(`^-(?:` + priceForms + `)`)
+	priceAtDollar       = regexp.MustCompile(`^(?:` + priceDollars + `)`)
+	priceAtCapital      = regexp.MustCompile(`^(?:` + priceDollars + `|` + priceRupeesBefore + `|` + priceCurrencyBefore + `)`)
+	priceAtSymbol       = regexp.MustCompile(`^(?:` + priceRupeesBefore + `|` + priceCurrencyBefore + `)`)
+	priceBeforeCurrency = regexp.MustCompile(`^(?:` + priceRupeesAfter + `|` + priceSymbolAfter + `|` + priceCodeAfter + `)`)
+)
+
+// findPrices works like PriceRegex.FindAllStringIndex, without running the
+// long expression over all of text. It only tries the places a price can
+// start at, in the words around a currency symbol or code, with the part of
+// the expression that can match there.
+func findPrices(text string, n int) [][]int {
+	var locs [][]int
+	pos := 0
+	for _, span := range priceSpans(text) {
+		i := span[0]
+		if i < pos {
+			i = pos
+		}
+		for ; i < span[1] && (n < 0 || len(locs) < n); i++ {
+			regex := priceRegexAt(text, i)
+			if regex == nil {
+				continue
+			}
+			if loc := regex.FindStringIndex(text[i:span[1]]); loc != nil {
+				locs = append(locs, []int{i, i + loc[1]})
+				pos = i + loc[1]
+				i = pos - 1
+			}
+		}
+	}
+	return locs
+}
+
+// priceRegexAt returns the part of PricePattern a price starting at text[i]
+// can match, or nil if none can start there. 0xC2 and 0xE2 are the first
+// bytes of "£", "¥", "€", "₹" and "₩". A capital starts a price only before
+// another capital or a "$", as in "USD" or "C$", and a digit or capital only
+// at the start of a word.
+func priceRegexAt(text string, i int) *regexp.Regexp {
+	switch b := text[i]; {
+	case b == '(':
+		return priceInBrackets
+	case b == '-':
+		return priceNegative
+	case b == '$':
+		return priceAtDollar
+	case b == 0xc2 || b == 0xe2:
+		return priceAtSymbol
+	case i > 0 && isWordByte(text[i-1]):
+		return nil
+	case isCapitalByte(b):
+		if i+1 < len(text) && (isCapitalByte(text[i+1]) || text[i+1] == '$') {
+			return priceAtCapital
+		}
+	case '0' <= b && b <= '9':
+		return priceBeforeCurrency
+	}
+	return nil
+}
+
+// priceDelimiters marks the bytes no price can hold, which a price can be
+// cut from the text at
+var priceDelimiters = segmentDelimiters(PricePattern)
+
+// priceSpans returns the parts of text PricePattern can match in: the words
+// around each currency symbol or code, up to any byte no price can hold. A
+// price holds at most one whitespace character, so it lies within the word
+// holding its symbol or code and the words on either side, and cutting at
+// whitespace or a delimiter changes no word boundary.
+func priceSpans(text string) [][2]int {
+	inWord := func(b byte) bool {
+		return !isSpaceByte(b) && !priceDelimiters[b]
+	}
+	var spans [][2]int
+	for _, i := range currencyOffsets(text) {
+		start, end := i, i
+		for start > 0 && inWord(text[start-1]) {
+			start--
+		}
+		if start > 0 && isSpaceByte(text[start-1]) {
+			start--
+			for start > 0 && inWord(text[start-1]) {
+				start--
+			}
+		}
+		for end < len(text) && inWord(text[end]) {
+			end++
+		}
+		if end < len(text) && isSpaceByte(text[end]) {
+			end++
+			for end < len(text) && inWord(text[end]) {
+				end++
+			}
+		}
+		if n := len(spans); n > 0 && start <= spans[n-1][1] {
+			spans[n-1][1] = end
+		} else {
+			spans = append(spans, [2]int{start, end})
+		}
+	}
+	return spans
+}
+
+// currencyOffsets returns the offsets of the currency symbols and codes in
+// text, in order. Symbols are found by their first byte: "$", or 0xC2 and
+// 0xE2 for "£", "¥", "€", "₹" and "₩". Codes are three capitals, so only
+// every third byte needs looking at to find them.
+func currencyOffsets(text string) []int {
+	var offsets []int
+	for _, b := range []byte{'$', 0xc2, 0xe2} {
+		for i := 0; i < len(text); i++ {
+			j := strings.IndexByte(text[i:], b)
+			if j < 0 {
+				break
+			}
+			if i += j; currencySymbolAt(text, i) {
+				offsets = append(offsets, i)
+			}
+		}
+	}
+	for i := 2; i < len(text); i += 3 {
+		// A code is followed by no letter, so a capital before a small
+		// letter, as in "Mar", needs no closer look.
+		if isCapitalByte(text[i]) && (i+1 == len(text) || !isSmallByte(text[i+1])) {
+			var start int
+			if start, i = capitalsAround(text, i); i-start == 3 && currencyCodeAt(text, start) {
+				offsets = append(offsets, start)
+			}
+			// Look again two bytes after the capitals, as text[i] is none.
+			i--
+		}
+	}
+	sort.Ints(offsets)
+	return offsets
+}
+
+// capitalsAround returns the start and end of the capitals around text[i]
+func capitalsAround(text string, i int) (start, end int) {
+	start, end = i, i+1
+	for start > 0 && isCapitalByte(text[start-1]) {
+		start--
+	}
+	for end < len(text) && isCapitalByte(text[end]) {
+		end++
+	}
+	return start, end
+}
+
+// isCurrencyCode holds the codes of currencyCodes
+var isCurrencyCode = func() map[string]bool {
+	codes := make(map[string]bool, len(currencyCodes))
+	for _, code := range currencyCodes {
+		codes[code] = true
+	}
+	return codes
+}()
+
+// currencySymbolAt reports whether a currency symbol begins at text[i]
+func currencySymbolAt(text string, i int) bool {
+	for _, c := range currencySymbols {
+		if strings.HasPrefix(text[i:], c.symbol) {
+			return true
+		}
+	}
+	return false
+}
+
+// currencyCodeAt reports whether a currency code starting a word begins at
+// text[i]. A code is never followed by a letter in a price.
+func currencyCodeAt(text string, i int) bool {
+	if i > 0 && isWordByte(text[i-1]) || i+3 > len(text) || i+3 < len(text) && isLetterByte(text[i+3]) {
+		return false
+	}
+	return isCurrencyCode[text[i:i+3]]
+}
+
+// isLetterByte reports whether b is an ASCII letter
+func isLetterByte(b byte) bool {
+	return 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z'
+}
+
+// isCapitalByte reports whether b is an ASCII capital letter
+func isCapitalByte(b byte) bool {
+	return 'A' <= b && b <= 'Z'
+}
+
+// isSmallByte reports whether b is an ASCII small letter
+func isSmallByte(b byte) bool {
+	return 'a' <= b && b <= 'z'
+}
+
+// isSpaceByte reports whether b is a space as \s matches it
+func isSpaceByte(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\f' || b == '\r'
+}
+
+// parseAmount returns the number written in s, with "," or "." as thousands
+// separators or decimal point, times 10 to the power of places
+func parseAmount(s string, negative bool, places int) Decimal {
+	point := strings.LastIndexAny(s, ".,")
+	if len(s)-point-1 > 2 {
+		// "1,200" or "1.200.000": the separators are all thousands
+		point = -1
+	}
+	whole, fraction := s, ""
+	if point >= 0 {
+		whole, fraction = s[:point], s[point+1:]
+	}
+	whole = strings.NewReplacer(",", "", ".", "").Replace(whole)
+	return newDecimal(negative, whole, fraction, places)
+}
+
+// newDecimal returns the decimal with the given whole and fraction digits,
+// its point moved right by places, or left if places is negative
+func newDecimal(negative bool, whole, fraction string, places int) Decimal {
+	for ; places > 0; places-- {
+		if fraction != "" {
+			whole, fraction = whole+fraction[:1], fraction[1:]
+		} else {
+			whole += "0"
+		}
+	}
+	for ; places < 0; places++ {
+		if whole != "" {
+			whole, fraction = whole[:len(whole)-1], whole[len(whole)-1:]+fraction
+		} else {
+			fraction = "0" + fraction
+		}
+	}
+	whole = strings.TrimLeft(whole, "0")
+	if whole == "" {
+		whole = "0"
+	}
+
+	amount := whole
+	if fraction != "" {
+		amount += "." + fraction
+	}
+	if negative && strings.Trim(amount, "0.") != "" {
+		amount = "-" + amount
+	}
+	return Decimal(amount)
+}
+
+// FindMoney finds the amounts of money in text, like Prices, and reads them
+// as ParseMoney does. The Limit, Unique, CaseFold, SortByFrequency,
+// Normalized and Deobfuscated options apply.
+func FindMoney(text string, opts ...Option) []Money {
+	o := newOptions(opts)
+	var found []Money
+	var values []string
+	for _, match := range o.prepare(text).scan([]Kind{KindPrice}) {
+		m, ok := ParseMoney(match.Value)
+		if !ok {
+			continue
+		}
+		m.Start, m.End = match.Start, match.End
+		found = append(found, m)
+		values = append(values, match.Value)
+		if o.limit > 0 && !o.unique && len(found) == o.limit {
+			break
+		}
+	}
+
+	return keepFound(o, found, values)
+}
@@ -0,0 +1,137 @@
+package commonregex
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_ParseMoney(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []struct {
+		value    string
+		currency string
+		amount   Decimal
+	}{
+		{"$1.23", "USD", "1.23"},
+		{"$10,000.00", "USD", "10000.00"},
+		{"€1.200,50", "EUR", "1200.50"},
+		{"€1.200", "EUR", "1200"},
+		{"€1,5", "EUR", "1.5"},
+		{"USD 1,200", "USD", "1200"},
+		{"1.200,50 EUR", "EUR", "1200.50"},
+		{"10 EUR", "EUR", "10"},
+		{"100€", "EUR", "100"},
+		{"-£5.99", "GBP", "-5.99"},
+		{"£-5.99", "GBP", "-5.99"},
+		{"USD -7", "USD", "-7"},
+		{"(¥1,000)", "JPY", "-1000"},
+		{"₹500", "INR", "500"},
+		{"₹1,00,000", "INR", "100000"},
+		{"₹12,34,567.50", "INR", "1234567.50"},
+		{"INR 1,50,000", "INR", "150000"},
+		{"12,34,567 ₹", "INR", "1234567"},
+		{"₹100,000", "INR", "100000"},
+		{"₩10,000", "KRW", "10000"},
+		{"$2.5M", "USD", "2500000"},
+		{"$0.015k", "USD", ""},
+		{"$5k", "USD", "5000"},
+		{"£1.25k", "GBP", "1250"},
+		{"€3bn", "EUR", "3000000000"},
+		{"C$ 20", "CAD", "20"},
+		{"US$20", "USD", "20"},
+		{"R$ 1.234,56", "BRL", "1234.56"},
+		{"-$0.00", "USD", "0.00"},
+		{"$007", "USD", "7"},
+	}
+
+	for _, test := range tests {
+		m, ok := ParseMoney(test.value)
+		if test.amount == "" {
+			assert.False(ok, "%s should not be parsed", test.value)
+			continue
+		}
+		if assert.True(ok, "%s should be parsed", test.value) {
+			assert.Equal(test.value, m.Value)
+			assert.Equal(test.currency, m.Currency, "currency of %s", test.value)
+			assert.Equal(string(test.amount), string(m.Amount), "amount of %s", test.value)
+		}
+	}
+
+	for _, s := range []string{"", "$", "1,200", "EUR", "$5 and $6", " $5"} {
+		_, ok := ParseMoney(s)
+		assert.False(ok, "%q should not be parsed", s)
+	}
+}
+
+func TestMoney_DecimalRat(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	m, _ := ParseMoney("€0,10")
+	sum := new(big.Rat)
+	for i := 0; i < 3; i++ {
+		sum.Add(sum, m.Amount.Rat())
+	}
+	assert.Equal("0.30", sum.FloatString(2))
+	assert.Equal(0, sum.Cmp(big.NewRat(3, 10)), "three times 0.10 should be exactly 0.30")
+	assert.Equal("-1200.50", Decimal("-1200.50").Rat().FloatString(2))
+}
+
+func TestMoney_FindMoney(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := "Invoice: 3 items at $1,000.50, shipping 12,50 € and a refund of (USD 20), fee 500 USD."
+
+	tests := []struct {
+		value    string
+		currency string
+		amount   Decimal
+	}{
+		{"$1,000.50", "USD", "1000.50"},
+		{"12,50 €", "EUR", "12.50"},
+		{"(USD 20)", "USD", "-20"},
+		{"500 USD", "USD", "500"},
+	}
+
+	found := FindMoney(text)
+	if assert.Len(found, len(tests)) {
+		for i, test := range tests {
+			assert.Equal(test.value, found[i].Value)
+			assert.Equal(test.value, text[found[i].Start:found[i].End])
+			assert.Equal(test.currency, found[i].Currency)
+			assert.Equal(string(test.amount), string(found[i].Amount))
+		}
+	}
+
+	assert.Len(FindMoney(text, Limit(2)), 2)
+	assert.Len(FindMoney(text+" "+text, Unique()), len(tests))
+
+	for _, text := range []string{"$1,10,0", "₹1,00,00", "100 EURO"} {
+		assert.Empty(FindMoney(text), "no amounts in %q", text)
+	}
+}
+
+func TestMoney_FindPrices(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"Invoice: 3 items at $1,000.50, shipping 12,50 € and a refund of (USD 20).",
+		"paid USD $ 5 and US$ $ 0",
+		"a$5, x(USD 5), -€3 and 5 EUR5",
+		`<td class="price">$34.99</td><td>978-0-13-419044-0</td>`,
+		"HTTP 200 GBP 4 INR 12,34,567 TRY",
+		"₹ 1,00,000 and 2,00,000 ₹ or 5bn JPY",
+		"USD",
+		"",
+	}
+
+	for _, test := range tests {
+		assert.Equal(PriceRegex.FindAllStringIndex(test, -1), findPrices(test, -1), "in %q", test)
+	}
+}
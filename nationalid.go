@@ -0,0 +1,67 @@
+package commonregex
+
+// nationalIDKinds lists the national identifier kinds with the ISO 3166
+// country that issues them
+var nationalIDKinds = []struct {
+	kind    Kind
+	country string
+}{
+	{KindSSN, "US"},
+	{KindNINO, "GB"},
+	{KindSIN, "CA"},
+	{KindNIR, "FR"},
+	{KindSteuerID, "DE"},
+	{KindFiscalCode, "IT"},
+	{KindDNI, "ES"},
+	{KindNIE, "ES"},
+	{KindBSN, "NL"},
+	{KindAadhaar, "IN"},
+	{KindPAN, "IN"},
+	{KindCPF, "BR"},
+	{KindCNPJ, "BR"},
+	{KindRRN, "KR"},
+}
+
+// NationalID is a national identification number found by FindNationalIDs
+type NationalID struct {
+	// Country is the ISO 3166-1 alpha-2 code of the issuing country
+	Country string
+	Kind    Kind
+	Value   string
+	// Start and End are the offsets of the number in the text searched
+	Start, End int
+}
+
+// FindNationalIDs finds the national identification numbers in text that
+// pass the checks of their kind, in the order they appear. A number that is
+// valid in more than one scheme, such as nine digits passing both the
+// Canadian and the Dutch check, is reported once for each. The Limit, Unique,
+// CaseFold, SortByFrequency, Normalized and Deobfuscated options apply.
+func FindNationalIDs(text string, opts ...Option) []NationalID {
+	o := newOptions(opts)
+	kinds := make([]Kind, len(nationalIDKinds))
+	index := make(map[Kind]int, len(nationalIDKinds))
+	for i, n := range nationalIDKinds {
+		kinds[i] = n.kind
+		index[n.kind] = i
+	}
+
+	var found []NationalID
+	var values []string
+	for _, m := range o.prepare(text).scan(kinds) {
+		n := nationalIDKinds[index[m.Kind]]
+		found = append(found, NationalID{
+			Country: n.country,
+			Kind:    m.Kind,
+			Value:   m.Value,
+			Start:   m.Start,
+			End:     m.End,
+		})
+		values = append(values, m.Value)
+		if o.limit > 0 && !o.unique && len(found) == o.limit {
+			break
+		}
+	}
+
+	return keepFound(o, found, values)
+}
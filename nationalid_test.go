@@ -0,0 +1,54 @@
+package commonregex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNationalID_FindNationalIDs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := `SSN 123-45-6789 (not 000-12-3456), NINO AB 12 34 56 C, Steuer-ID 86095742719,
+codice fiscale RSSMRA85T10A562S, DNI 12345678Z, CPF 529.982.247-25, CNPJ 11.222.333/0001-80.`
+
+	tests := []struct {
+		country string
+		kind    Kind
+		value   string
+	}{
+		{"US", KindSSN, "123-45-6789"},
+		{"GB", KindNINO, "AB 12 34 56 C"},
+		{"DE", KindSteuerID, "86095742719"},
+		{"IT", KindFiscalCode, "RSSMRA85T10A562S"},
+		{"ES", KindDNI, "12345678Z"},
+		{"BR", KindCPF, "529.982.247-25"},
+	}
+
+	found := FindNationalIDs(text)
+	if assert.Len(found, len(tests)) {
+		for i, test := range tests {
+			assert.Equal(test.country, found[i].Country)
+			assert.Equal(string(test.kind), string(found[i].Kind))
+			assert.Equal(test.value, found[i].Value)
+			assert.Equal(test.value, text[found[i].Start:found[i].End])
+		}
+	}
+
+	assert.Len(FindNationalIDs(text, Limit(3)), 3)
+	assert.Len(FindNationalIDs(text+" "+text, Unique()), len(tests))
+}
+
+func TestNationalID_FindNationalIDsSeveralSchemes(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	// 123456733 passes both the Luhn check of Canadian SINs and the eleven
+	// test of Dutch BSNs, 130692544 only the former.
+	var found []string
+	for _, id := range FindNationalIDs("123456733 130692544") {
+		found = append(found, id.Country+":"+id.Value)
+	}
+	assert.Equal([]string{"CA:123456733", "NL:123456733", "CA:130692544"}, found)
+}
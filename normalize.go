@@ -0,0 +1,121 @@
+package commonregex
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Normalize folds text to the ASCII forms the patterns are written for:
+//
+//   - full-width, half-width and small form variants of ASCII characters,
+//     like "０３" or "＠", to ASCII, as NFKC does
+//   - decimal digits of every script, like Arabic-Indic "٣", and superscript
+//     and subscript digits to ASCII digits
+//   - no-break, ideographic and other spaces to ' ', and line and paragraph
+//     separators to '\n'
+//   - dashes and the minus sign to '-'
+//   - zero-width spaces and joiners, word joiners, byte order marks and soft
+//     hyphens are dropped
+//
+// It only covers the part of NFKC which matters to the patterns, without
+// decomposing letters. Use ScanNormalized to find the offsets of the matches
+// in the original text, or the Normalized option of the finders.
+func Normalize(text string) string {
+	return normalize(text).String()
+}
+
+// ScanNormalized finds the matches of the given kinds, or of every built-in
+// kind if none are given, in the text folded by Normalize. Value holds the
+// folded match while Start and End are the offsets of its source in text.
+func ScanNormalized(text string, kinds ...Kind) []Match {
+	return normalize(text).scan(kinds)
+}
+
+func normalize(text string) *mappedText {
+	m := &mappedText{}
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if r < utf8.RuneSelf || r == utf8.RuneError && size == 1 {
+			m.copy(text, i, i+size)
+		} else if folded, ok := foldRune(r); ok {
+			m.replace(folded, i, i+size)
+		} else {
+			m.copy(text, i, i+size)
+		}
+		i += size
+	}
+	return m
+}
+
+// smallForms maps the small form variants to ASCII
+var smallForms = map[rune]rune{
+	'﹐': ',', '﹒': '.', '﹔': ';', '﹕': ':', '﹖': '?',
+	'﹗': '!', '﹘': '-', '﹙': '(', '﹚': ')', '﹛': '{',
+	'﹜': '}', '﹟': '#', '﹠': '&', '﹡': '*', '﹢': '+',
+	'﹣': '-', '﹤': '<', '﹥': '>', '﹦': '=', '﹨': '\\',
+	'﹩': '$', '﹪': '%', '﹫': '@',
+}
+
+// fullWidthSigns maps the full-width signs outside the ASCII block to their
+// normal width
+var fullWidthSigns = map[rune]rune{
+	'￠': '¢', '￡': '£', '￢': '¬', '￣': '¯', '￤': '¦',
+	'￥': '¥', '￦': '₩',
+}
+
+// foldRune returns what Normalize replaces r with, if anything
+func foldRune(r rune) (string, bool) {
+	switch {
+	case '！' <= r && r <= '～':
+		return string(r - 0xFEE0), true
+	case r == '¹':
+		return "1", true
+	case r == '²' || r == '³':
+		return string('2' + r - '²'), true
+	case r == '⁰':
+		return "0", true
+	case '⁴' <= r && r <= '⁹':
+		return string('4' + r - '⁴'), true
+	case '₀' <= r && r <= '₉':
+		return string('0' + r - '₀'), true
+	case r == '\u2028' || r == '\u2029' || r == '\u0085':
+		return "\n", true
+	case r == '\u200b' || r == '\u200c' || r == '\u200d' || r == '\u2060' || r == '\ufeff' || r == '\u00ad':
+		return "", true
+	case r == '\u2212':
+		return "-", true
+	}
+	if s, ok := smallForms[r]; ok {
+		return string(s), true
+	}
+	if s, ok := fullWidthSigns[r]; ok {
+		return string(s), true
+	}
+	if d, ok := digitValue(r); ok {
+		return string('0' + d), true
+	}
+	if unicode.Is(unicode.Zs, r) {
+		return " ", true
+	}
+	if unicode.Is(unicode.Pd, r) {
+		return "-", true
+	}
+	return "", false
+}
+
+// digitValue returns the value of a decimal digit. Every script's digits
+// are a run of ten code points from zero to nine, so the value is the
+// offset into the run.
+func digitValue(r rune) (rune, bool) {
+	for _, rng := range unicode.Nd.R16 {
+		if lo, hi := rune(rng.Lo), rune(rng.Hi); lo <= r && r <= hi {
+			return (r - lo) % 10, true
+		}
+	}
+	for _, rng := range unicode.Nd.R32 {
+		if lo, hi := rune(rng.Lo), rune(rng.Hi); lo <= r && r <= hi {
+			return (r - lo) % 10, true
+		}
+	}
+	return 0, false
+}
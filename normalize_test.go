@@ -0,0 +1,61 @@
+package commonregex
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []struct {
+		text, want string
+	}{
+		{"０３-１２３４-５６７８", "03-1234-5678"},
+		{"ｊｏｈｎ＠ｅｘａｍｐｌｅ．ｃｏｍ", "john@example.com"},
+		{"٠١٢٣٤٥٦٧٨٩ ۰۱۲ ०१२ ๐๑๒", "0123456789 012 012 012"},
+		{"$\u00a01,234.50", "$ 1,234.50"},
+		{"5\u2009000\u202fkm", "5 000 km"},
+		{"555–123—4567 −5", "555-123-4567 -5"},
+		{"12\u200b3-45-\u00ad6789", "123-45-6789"},
+		{"x² + y³ = z₁₀", "x2 + y3 = z10"},
+		{"price＄５ ￥100 ﹫", "price$5 ¥100 @"},
+		{"line\u2028break", "line\nbreak"},
+		{"café naïve 日本", "café naïve 日本"},
+		{"bad \xff byte", "bad \xff byte"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.want, Normalize(test.text), test.text)
+	}
+}
+
+func TestScanNormalized(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	phone := "（５５５）１２３-４５６７"
+	price := "$\u00a01,234.50"
+	text := "電話 " + phone + " or " + price
+	matches := ScanNormalized(text, KindPhone, KindPrice)
+	start := strings.Index(text, phone)
+	assert.Equal([]Match{
+		{KindPhone, "(555)123-4567", start, start + len(phone)},
+		{KindPrice, "$ 1,234.50", len(text) - len(price), len(text)},
+	}, matches)
+}
+
+func TestOptions_Normalized(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := "SSN ١٢٣-٤٥-٦٧٨٩, mail ｊｏｈｎ＠ｅｘａｍｐｌｅ．ｃｏｍ"
+
+	assert.Nil(SSNs(text))
+	assert.Equal([]string{"123-45-6789"}, SSNs(text, Normalized()))
+	assert.Equal([]string{"john@example.com"}, Emails(text, Normalized()))
+	assert.Equal([]string{"harold@example.com"}, Emails("ｈａｒｏｌｄ［ａｔ］ｅｘａｍｐｌｅ［．］ｃｏｍ", Normalized(), Deobfuscated()))
+}
@@ -0,0 +1,162 @@
+package commonregex
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Option changes which matches a finder returns and in which order. Without
+// options, a finder returns every match in the order found, duplicates
+// included. Options only some finders use, like InLocale or RelativeTo, name
+// them; the other finders ignore these options. The offsets finders return,
+// like the Start and End of a Money, are into the text given even when
+// Normalized or Deobfuscated rewrites it.
+type Option func(*options)
+
+type options struct {
+	limit       int
+	unique      bool
+	caseFold    bool
+	byFrequency bool
+	deobfuscate bool
+	normalize   bool
+	// excludeFileExtensions is only used by the domain finders
+	excludeFileExtensions bool
+	// postalRegions and checkZIP3 are only used by the postal code finders
+	postalRegions []Region
+	checkZIP3     bool
+	// locale is only used by the finders that read numbers and dates
+	locale Locale
+	// reference is only used by the relative time and timestamp finders
+	reference time.Time
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// Limit returns at most n matches. Without other options, the search stops
+// as soon as n matches are found.
+func Limit(n int) Option {
+	return func(o *options) {
+		o.limit = n
+	}
+}
+
+// Unique drops repeated matches, keeping the first occurrence of each
+func Unique() Option {
+	return func(o *options) {
+		o.unique = true
+	}
+}
+
+// CaseFold makes Unique and SortByFrequency treat matches differing only in
+// case as the same. The spelling of the first occurrence is returned.
+func CaseFold() Option {
+	return func(o *options) {
+		o.caseFold = true
+	}
+}
+
+// SortByFrequency returns each distinct match once, the most frequent first.
+// Matches occurring equally often keep the order they were first found in.
+func SortByFrequency() Option {
+	return func(o *options) {
+		o.unique = true
+		o.byFrequency = true
+	}
+}
+
+// Deobfuscated searches the text as rewritten by Deobfuscate, so that
+// obfuscated values like "harold[at]gmail[.]com" are found. The rewritten
+// matches are returned.
+func Deobfuscated() Option {
+	return func(o *options) {
+		o.deobfuscate = true
+	}
+}
+
+// Normalized searches the text as folded by Normalize, so that values
+// written with full-width or non-ASCII digits, exotic spaces or dashes are
+// found. The folded matches are returned.
+func Normalized() Option {
+	return func(o *options) {
+		o.normalize = true
+	}
+}
+
+func (o options) key(s string) string {
+	if o.caseFold {
+		return strings.ToLower(s)
+	}
+	return s
+}
+
+// apply dedupes, sorts and limits matches as the options ask for
+func (o options) apply(matches []string) []string {
+	return keepFound(o, matches, matches)
+}
+
+// keepFound returns the values found whose matches keep keeps, in the order
+// it keeps them. The matches are the text of the values, as the options
+// compare them.
+func keepFound[T any](o options, found []T, matches []string) []T {
+	kept := o.keep(matches)
+	if len(kept) == len(found) {
+		return found
+	}
+	out := make([]T, len(kept))
+	for i, k := range kept {
+		out[i] = found[k]
+	}
+	return out
+}
+
+// keep returns the indices of the matches apply returns, in order
+func (o options) keep(matches []string) []int {
+	var kept []int
+	if o.unique {
+		counts := make(map[string]int, len(matches))
+		for i, m := range matches {
+			k := o.key(m)
+			if counts[k] == 0 {
+				kept = append(kept, i)
+			}
+			counts[k]++
+		}
+		if o.byFrequency {
+			sort.SliceStable(kept, func(i, j int) bool {
+				return counts[o.key(matches[kept[i]])] > counts[o.key(matches[kept[j]])]
+			})
+		}
+	} else {
+		kept = make([]int, len(matches))
+		for i := range kept {
+			kept[i] = i
+		}
+	}
+	if o.limit > 0 && len(kept) > o.limit {
+		kept = kept[:o.limit]
+	}
+	return kept
+}
+
+// prepare returns the text to search as rewritten by the Normalized and
+// Deobfuscated options, mapped to text
+func (o options) prepare(text string) *mappedText {
+	var m *mappedText
+	if o.normalize {
+		m = normalize(text)
+	} else {
+		m = newMappedText(text)
+	}
+	if o.deobfuscate {
+		m = deobfuscate(m)
+	}
+	return m
+}
@@ -0,0 +1,50 @@
+package commonregex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptions_Limit(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := "a@example.com b@example.com a@example.com c@example.com"
+
+	assert.Equal([]string{"a@example.com", "b@example.com"}, Emails(text, Limit(2)))
+	assert.Len(Emails(text, Limit(10)), 4)
+	assert.Len(Emails(text, Limit(0)), 4, "a zero limit means no limit")
+}
+
+func TestOptions_Unique(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := "10.0.0.1 10.0.0.2 10.0.0.1 10.0.0.3 10.0.0.2"
+
+	assert.Equal([]string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, IPv4s(text, Unique()))
+	assert.Equal([]string{"10.0.0.1", "10.0.0.2"}, IPv4s(text, Unique(), Limit(2)))
+}
+
+func TestOptions_CaseFold(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := "John@Example.com john@example.com JOHN@EXAMPLE.COM jane@example.com"
+
+	assert.Len(Emails(text, Unique()), 4)
+	assert.Equal([]string{"John@Example.com", "jane@example.com"}, Emails(text, Unique(), CaseFold()))
+	assert.Len(Emails(text, CaseFold()), 4, "CaseFold alone does not dedupe")
+}
+
+func TestOptions_SortByFrequency(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := "#fff #000 #abc #000 #ABC #abc #000"
+
+	assert.Equal([]string{"#000", "#abc", "#fff", "#ABC"}, HexColors(text, SortByFrequency()))
+	assert.Equal([]string{"#abc", "#000", "#fff"}, HexColors(text+" #Abc", SortByFrequency(), CaseFold()))
+	assert.Equal([]string{"#000"}, HexColors(text, SortByFrequency(), Limit(1)))
+}
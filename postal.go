@@ -0,0 +1,247 @@
+package commonregex
+
+import (
+	_ "embed" // for the ZIP3 table
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Region groups the countries the postal code finders know
+type Region string
+
+// Regions of the postal code formats
+const (
+	RegionNorthAmerica Region = "north_america"
+	RegionSouthAmerica Region = "south_america"
+	RegionEurope       Region = "europe"
+	RegionAsia         Region = "asia"
+	RegionOceania      Region = "oceania"
+)
+
+// postalFormat is the postal code format of a country
+type postalFormat struct {
+	// country is the ISO 3166-1 alpha-2 code of the country
+	country string
+	region  Region
+	regex   *regexp.Regexp
+	// valid, if set, rejects matches of regex the format does not allow
+	valid func(string) bool
+}
+
+func newPostalFormat(country string, region Region, pattern string, valid func(string) bool) postalFormat {
+	return postalFormat{
+		country: country,
+		region:  region,
+		regex:   regexp.MustCompile(`\b(?:` + pattern + `)\b`),
+		valid:   valid,
+	}
+}
+
+// Postal code formats the address parser also uses
+const (
+	canadianPostalCodePattern = `[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d`
+	ukPostcodePattern         = `[A-PR-UWYZ][A-HK-Y]?\d[A-Z\d]? ?\d[ABD-HJLNP-UW-Z]{2}|GIR ?0AA`
+)
+
+// postalFormats lists the postal code formats FindPostalCodes looks for
+var postalFormats = []postalFormat{
+	newPostalFormat("US", RegionNorthAmerica, `\d{5}(?:-\d{4})?`, nil),
+	newPostalFormat("CA", RegionNorthAmerica, canadianPostalCodePattern, nil),
+	newPostalFormat("MX", RegionNorthAmerica, `\d{5}`, nil),
+	newPostalFormat("BR", RegionSouthAmerica, `\d{5}-\d{3}`, nil),
+	newPostalFormat("AR", RegionSouthAmerica, `[A-HJ-NP-Z]\d{4}[A-Z]{3}`, nil),
+	newPostalFormat("GB", RegionEurope, ukPostcodePattern, nil),
+	newPostalFormat("IE", RegionEurope, `(?:[AC-FHKNPRTV-Y]\d{2}|D6W) ?[\dAC-FHKNPRTV-Y]{4}`, nil),
+	newPostalFormat("DE", RegionEurope, `\d{5}`, nil),
+	newPostalFormat("FR", RegionEurope, `\d{5}`, nil),
+	newPostalFormat("IT", RegionEurope, `\d{5}`, nil),
+	newPostalFormat("ES", RegionEurope, `(?:0[1-9]|[1-4]\d|5[0-2])\d{3}`, nil),
+	newPostalFormat("NL", RegionEurope, `[1-9]\d{3} ?[A-Z]{2}`, validDutchPostalCode),
+	newPostalFormat("BE", RegionEurope, `[1-9]\d{3}`, nil),
+	newPostalFormat("CH", RegionEurope, `[1-9]\d{3}`, nil),
+	newPostalFormat("AT", RegionEurope, `[1-9]\d{3}`, nil),
+	newPostalFormat("PL", RegionEurope, `\d{2}-\d{3}`, nil),
+	newPostalFormat("PT", RegionEurope, `[1-9]\d{3}-\d{3}`, nil),
+	newPostalFormat("JP", RegionAsia, `\d{3}-\d{4}`, nil),
+	newPostalFormat("IN", RegionAsia, `[1-9]\d{2} ?\d{3}`, nil),
+	newPostalFormat("CN", RegionAsia, `\d{6}`, nil),
+	newPostalFormat("KR", RegionAsia, `\d{5}`, nil),
+	newPostalFormat("AU", RegionOceania, `\d{4}`, nil),
+}
+
+var (
+	// postalKeywordRegex matches the words for a postal code that may be
+	// written near one
+	postalKeywordRegex = regexp.MustCompile(`(?i)\b(?:zip(?:\s*code)?|postal\s*code|post\s*code|postcode|postleitzahl|PLZ|CEP|CAP|code\s+postal|c[oó]digo\s+postal|pin\s*code|pincode)s?\b`)
+	// postalStateRegex matches the state or province an address writes just
+	// before its postal code, as in "IL 62704" or "NSW 2000"
+	postalStateRegex = regexp.MustCompile(`\b[A-Z]{2,3}\.?,?[ \t]+$`)
+)
+
+// postalContext reports whether the postal code text[start:end] follows a
+// state or province, or has a word for a postal code within contextWindow
+// bytes of it
+func postalContext(text string, start, end int) bool {
+	before := text[:start]
+	if len(before) > 8 {
+		before = before[len(before)-8:]
+	}
+	return postalStateRegex.MatchString(before) || postalKeywordRegex.MatchString(around(text, start, end))
+}
+
+// validDutchPostalCode rejects the letter pairs SA, SD and SS, which Dutch
+// postcodes never use
+func validDutchPostalCode(code string) bool {
+	letters := code[len(code)-2:]
+	return letters != "SA" && letters != "SD" && letters != "SS"
+}
+
+// PostalRegions makes FindPostalCodes and PostalCodes only look for the
+// postal codes of countries in the given regions. Other finders ignore it.
+func PostalRegions(regions ...Region) Option {
+	return func(o *options) {
+		o.postalRegions = append(o.postalRegions, regions...)
+	}
+}
+
+// CheckZIP3 makes FindPostalCodes and PostalCodes skip US ZIP codes whose
+// first three digits are not an assigned prefix, as ValidZIPCode does. Other
+// finders ignore it.
+func CheckZIP3() Option {
+	return func(o *options) {
+		o.checkZIP3 = true
+	}
+}
+
+// zip3Table maps the assigned US ZIP code prefixes to the state, territory or
+// military mail region they serve, one range of prefixes per line
+//
+//go:embed data/zip3.txt
+var zip3Table string
+
+var (
+	zip3States     map[string]string
+	zip3StatesOnce sync.Once
+)
+
+// loadZIP3States parses the table on first use
+func loadZIP3States() {
+	zip3States = make(map[string]string)
+	for _, line := range strings.Split(zip3Table, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 || strings.HasPrefix(line, "//") {
+			continue
+		}
+		bounds := strings.SplitN(fields[0], "-", 2)
+		lo, _ := strconv.Atoi(bounds[0])
+		hi := lo
+		if len(bounds) == 2 {
+			hi, _ = strconv.Atoi(bounds[1])
+		}
+		for prefix := lo; prefix <= hi; prefix++ {
+			zip3States[strconv.Itoa(1000 + prefix)[1:]] = fields[1]
+		}
+	}
+}
+
+// ZIPState returns the postal code of the US state, territory or military
+// mail region ("AA", "AE" or "AP") a ZIP code is in, by its first three
+// digits, or "" if the prefix is not assigned or zip is not a ZIP code
+func ZIPState(zip string) string {
+	if len(zip) != 5 && (len(zip) != 10 || zip[5] != '-' || !isDigits(zip[6:])) || !isDigits(zip[:5]) {
+		return ""
+	}
+	zip3StatesOnce.Do(loadZIP3States)
+	return zip3States[zip[:3]]
+}
+
+// ValidZIPCode reports whether zip is a five-digit or ZIP+4 code whose first
+// three digits are an assigned prefix
+func ValidZIPCode(zip string) bool {
+	return ZIPState(zip) != ""
+}
+
+// PostalCode is a postal code found by FindPostalCodes
+type PostalCode struct {
+	Value string
+	// Countries are the ISO 3166-1 alpha-2 codes of the countries whose
+	// format the code fits, in the order of the formats. Many formats are
+	// the same, so "10115" fits the US, Mexico, Germany and others.
+	Countries []string
+	// Start and End are the offsets of the code in the text searched
+	Start, End int
+}
+
+// FindPostalCodes finds the postal codes of the countries it knows in text,
+// in the order they appear, or only those of the regions given with
+// PostalRegions. A code inside a longer one, like "12345" in the Brazilian
+// "12345-678", is skipped. Codes of digits only look like any other number,
+// so unless regions are given they only count after a state or province, as
+// in "IL 62704", or with a word such as "ZIP", "postcode" or "PLZ" nearby.
+// The Limit, Unique, CaseFold, SortByFrequency, Normalized, Deobfuscated,
+// PostalRegions and CheckZIP3 options apply.
+func FindPostalCodes(text string, opts ...Option) []PostalCode {
+	o := newOptions(opts)
+	m := o.prepare(text)
+	prepared := m.String()
+
+	regions := make(map[Region]bool, len(o.postalRegions))
+	for _, region := range o.postalRegions {
+		regions[region] = true
+	}
+	var found []PostalCode
+	index := make(map[[2]int]int)
+	for _, format := range postalFormats {
+		if len(regions) > 0 && !regions[format.region] {
+			continue
+		}
+		for _, loc := range format.regex.FindAllStringIndex(prepared, -1) {
+			value := prepared[loc[0]:loc[1]]
+			if format.valid != nil && !format.valid(value) ||
+				format.country == "US" && o.checkZIP3 && !ValidZIPCode(value) ||
+				len(regions) == 0 && isDigits(value) && !postalContext(prepared, loc[0], loc[1]) {
+				continue
+			}
+			key := [2]int{loc[0], loc[1]}
+			if i, ok := index[key]; ok {
+				found[i].Countries = append(found[i].Countries, format.country)
+				continue
+			}
+			index[key] = len(found)
+			found = append(found, PostalCode{Value: value, Countries: []string{format.country}, Start: loc[0], End: loc[1]})
+		}
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		if found[i].Start != found[j].Start {
+			return found[i].Start < found[j].Start
+		}
+		return found[i].End > found[j].End
+	})
+	var codes []PostalCode
+	var values []string
+	end := 0
+	for _, code := range found {
+		if code.End <= end {
+			continue
+		}
+		end = code.End
+		code.Start, code.End = m.span(code.Start, code.End)
+		codes = append(codes, code)
+		values = append(values, code.Value)
+	}
+
+	return keepFound(o, codes, values)
+}
+
+// PostalCodes returns the postal codes in text, like FindPostalCodes
+func PostalCodes(text string, opts ...Option) []string {
+	var values []string
+	for _, code := range FindPostalCodes(text, opts...) {
+		values = append(values, code.Value)
+	}
+	return values
+}
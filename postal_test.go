@@ -0,0 +1,139 @@
+package commonregex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostal_FindPostalCodes(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := `London SW1A 1AA, Toronto M5V 3L9, Amsterdam 1012 AB, Tokyo 100-0001,
+São Paulo 01310-100, New Delhi 110 001, Dublin D02 X285, Lisboa 1100-148, Berlin PLZ 10115,
+Beverly Hills, CA 90210, Sydney NSW 2000.`
+
+	tests := []struct {
+		value     string
+		countries []string
+	}{
+		{"SW1A 1AA", []string{"GB"}},
+		{"M5V 3L9", []string{"CA"}},
+		{"1012 AB", []string{"NL"}},
+		{"100-0001", []string{"JP"}},
+		{"01310-100", []string{"BR"}},
+		{"110 001", []string{"IN"}},
+		{"D02 X285", []string{"IE"}},
+		{"1100-148", []string{"PT"}},
+		{"10115", []string{"US", "MX", "DE", "FR", "IT", "ES", "KR"}},
+		{"90210", []string{"US", "MX", "DE", "FR", "IT", "KR"}},
+		{"2000", []string{"BE", "CH", "AT", "AU"}},
+	}
+
+	found := FindPostalCodes(text)
+	if assert.Len(found, len(tests)) {
+		for i, test := range tests {
+			assert.Equal(test.value, found[i].Value)
+			assert.Equal(test.countries, found[i].Countries)
+			assert.Equal(test.value, text[found[i].Start:found[i].End])
+		}
+	}
+
+	assert.Len(FindPostalCodes(text, Limit(3)), 3)
+	assert.Len(FindPostalCodes(text+" "+text, Unique()), len(tests))
+}
+
+func TestPostal_PostalCodes(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"EC1A 1BB",
+		"W1A 0AX",
+		"GIR 0AA",
+		"K1A 0B1",
+		"3528 BJ",
+		"C1425DKA",
+		"00-950",
+		"560 001",
+		"90210-1234",
+	}
+
+	failingTests := []string{
+		"QA1 1AA",
+		"D1A 1A1",
+		"1012 SS",
+		"0123 AB",
+		"123",
+		"1234567",
+	}
+
+	for _, test := range tests {
+		assert.Equal([]string{test}, PostalCodes(test), "they should be matched")
+	}
+
+	for _, test := range failingTests {
+		assert.NotEqual([]string{test}, PostalCodes(test), "they should not be matched")
+	}
+
+	assert.Empty(PostalCodes("Call 1234 or room 5678, year 2017"))
+	assert.Empty(PostalCodes("10115"))
+	assert.Equal([]string{"10115"}, PostalCodes("zip: 10115"))
+	assert.Equal([]string{"10115"}, PostalCodes("10115 Berlin", PostalRegions(RegionEurope)))
+}
+
+func TestPostal_PostalRegions(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := "SW1A 1AA, M5V 3L9, 100-0001, 2000"
+	assert.Equal([]string{"M5V 3L9"}, PostalCodes(text, PostalRegions(RegionNorthAmerica)))
+	assert.Equal([]string{"SW1A 1AA", "2000"}, PostalCodes(text, PostalRegions(RegionEurope)))
+	assert.Equal([]string{"100-0001", "2000"}, PostalCodes(text, PostalRegions(RegionAsia, RegionOceania)))
+
+	found := FindPostalCodes("10115", PostalRegions(RegionAsia))
+	if assert.Len(found, 1) {
+		assert.Equal([]string{"KR"}, found[0].Countries)
+	}
+}
+
+func TestPostal_CheckZIP3(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	found := FindPostalCodes("90210 and 00012", PostalRegions(RegionNorthAmerica), CheckZIP3())
+	if assert.Len(found, 2) {
+		assert.Equal([]string{"US", "MX"}, found[0].Countries)
+		assert.Equal([]string{"MX"}, found[1].Countries)
+	}
+	// The ZIP+4 code is rejected, leaving the Mexican code inside it
+	assert.Equal([]string{"90210-1234", "00012"}, PostalCodes("90210-1234 00012-3456", CheckZIP3(), PostalRegions(RegionNorthAmerica)))
+}
+
+func TestPostal_ZIPState(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := map[string]string{
+		"90210":      "CA",
+		"10001":      "NY",
+		"00501":      "NY",
+		"73301":      "TX",
+		"20500-0003": "DC",
+		"09001":      "AE",
+		"96799":      "HI",
+		"99501":      "AK",
+		"00012":      "",
+		"21301":      "",
+		"98701":      "",
+		"9021":       "",
+		"90210-12":   "",
+		"9021a":      "",
+	}
+
+	for zip, state := range tests {
+		assert.Equal(state, ZIPState(zip), "state of %s", zip)
+		assert.Equal(state != "", ValidZIPCode(zip), "validity of %s", zip)
+	}
+}
@@ -0,0 +1,191 @@
+package commonregex
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+// Byte classes used by the prefilter declarations
+const (
+	digitBytes = "0123456789"
+	upperBytes = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+// prefilter decides cheaply where a regular expression can match before
+// running it. Every match of the expression contains at least one byte of
+// each of the required byte sets, so a text lacking any of them is skipped
+// outright. Bytes no match can contain split the text into segments, and the
+// expression is only run on the segments containing all required sets.
+type prefilter struct {
+	required []string
+	// delimiters marks the bytes segments are split at. It is nil when the
+	// text cannot be split safely.
+	delimiters *[256]bool
+	// find, if set, finds up to n matches in a text holding the required
+	// bytes instead of the expression, the same way FindAllStringIndex does
+	// but knowing better where to look
+	find func(text string, n int) [][]int
+}
+
+func newPrefilter(regex *regexp.Regexp, required ...string) prefilter {
+	p := prefilter{required: required}
+	if len(required) > 0 {
+		p.delimiters = segmentDelimiters(regex.String())
+	}
+	return p
+}
+
+// mayMatch reports whether text contains a byte of each required set
+func (p prefilter) mayMatch(text string) bool {
+	for _, set := range p.required {
+		if indexAny(text, set) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// findAllIndex works like regexp's FindAllStringIndex, only skipping the parts
+// of text the expression cannot match
+func (p prefilter) findAllIndex(regex *regexp.Regexp, text string, n int) [][]int {
+	if !p.mayMatch(text) {
+		return nil
+	}
+	if p.find != nil {
+		return p.find(text, n)
+	}
+	if p.delimiters == nil {
+		return regex.FindAllStringIndex(text, n)
+	}
+
+	var locs [][]int
+	for offset := 0; offset < len(text) && (n < 0 || len(locs) < n); {
+		i := indexAny(text[offset:], p.required[0])
+		if i < 0 {
+			break
+		}
+		start, end := offset+i, offset+i+1
+		for start > offset && !p.delimiters[text[start-1]] {
+			start--
+		}
+		for end < len(text) && !p.delimiters[text[end]] {
+			end++
+		}
+		segment := text[start:end]
+		if p.mayMatch(segment) {
+			limit := -1
+			if n >= 0 {
+				limit = n - len(locs)
+			}
+			for _, loc := range regex.FindAllStringIndex(segment, limit) {
+				loc[0], loc[1] = start+loc[0], start+loc[1]
+				locs = append(locs, loc)
+			}
+		}
+		offset = end
+	}
+	return locs
+}
+
+// indexAny is strings.IndexAny with a fast path for single byte sets
+func indexAny(s, set string) int {
+	if len(set) == 1 {
+		return strings.IndexByte(s, set[0])
+	}
+	return strings.IndexAny(s, set)
+}
+
+// segmentDelimiters returns the bytes no match of the expression can contain
+// and which can be cut at without changing what the expression matches, or
+// nil if there are none
+func segmentDelimiters(expr string) *[256]bool {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return nil
+	}
+	var consumable [256]bool
+	wordBoundary, ok := scanSyntax(re, &consumable)
+	if !ok {
+		return nil
+	}
+
+	var delimiters [256]bool
+	found := false
+	for b := 0; b < 256; b++ {
+		// Cutting next to a word byte would turn it into a word boundary.
+		if consumable[b] || wordBoundary && isWordByte(byte(b)) {
+			continue
+		}
+		delimiters[b] = true
+		found = true
+	}
+	if !found {
+		return nil
+	}
+	return &delimiters
+}
+
+// scanSyntax marks the bytes a match of re can contain in consumable. It
+// reports whether re tests for word boundaries, and false as its second result
+// if re has assertions cutting the text would break.
+func scanSyntax(re *syntax.Regexp, consumable *[256]bool) (wordBoundary, ok bool) {
+	markRune := func(r rune) {
+		if r >= 0x80 {
+			for b := 0x80; b < 256; b++ {
+				consumable[b] = true
+			}
+			return
+		}
+		consumable[r] = true
+	}
+
+	switch re.Op {
+	case syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText:
+		return false, false
+	case syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		wordBoundary = true
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		for b := 0; b < 256; b++ {
+			if re.Op == syntax.OpAnyChar || b != '\n' {
+				consumable[b] = true
+			}
+		}
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			markRune(r)
+			if re.Flags&syntax.FoldCase != 0 {
+				for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+					markRune(f)
+				}
+			}
+		}
+	case syntax.OpCharClass:
+		for i := 0; i < len(re.Rune); i += 2 {
+			for r := re.Rune[i]; r <= re.Rune[i+1] && r < 0x80; r++ {
+				markRune(r)
+			}
+			if re.Rune[i+1] >= 0x80 {
+				markRune(0x80)
+			}
+		}
+	}
+	for _, sub := range re.Sub {
+		subWordBoundary, subOK := scanSyntax(sub, consumable)
+		if !subOK {
+			return false, false
+		}
+		wordBoundary = wordBoundary || subWordBoundary
+	}
+	return wordBoundary, true
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || '0' <= b && b <= '9' || 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z'
+}
+
+// caseless returns the bytes of s in both upper and lower case
+func caseless(s string) string {
+	return strings.ToLower(s) + strings.ToUpper(s)
+}
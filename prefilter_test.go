@@ -0,0 +1,65 @@
+package commonregex
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefilter_MayMatch(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	p := newPrefilter(EmailRegex, "@")
+	assert.True(p.mayMatch("john@example.com"))
+	assert.False(p.mayMatch("no at sign here"))
+
+	p = newPrefilter(TimeRegex, digitBytes, ":aApP")
+	assert.True(p.mayMatch("9am"))
+	assert.False(p.mayMatch("nine o'clock"))
+	assert.False(p.mayMatch("1 2 3"))
+
+	p = newPrefilter(HexColorRegex)
+	assert.True(p.mayMatch(""))
+}
+
+func TestPrefilter_Delimiters(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	delimiters := segmentDelimiters(EmailPattern)
+	assert.NotNil(delimiters)
+	assert.True(delimiters[' '])
+	assert.True(delimiters['\n'])
+	assert.False(delimiters['@'])
+	assert.False(delimiters['j'])
+
+	delimiters = segmentDelimiters(DatePattern)
+	assert.NotNil(delimiters)
+	assert.False(delimiters['\n'], "dates may span lines through \\s")
+	assert.False(delimiters['M'], "the pattern is case insensitive")
+	assert.True(delimiters['='])
+
+	delimiters = segmentDelimiters(IPv4Pattern)
+	assert.NotNil(delimiters)
+	assert.True(delimiters[' '])
+	assert.False(delimiters['x'], "cutting at a word byte would add a word boundary")
+
+	assert.Nil(segmentDelimiters(`(?s).+`))
+	assert.Nil(segmentDelimiters(`^\d+`))
+	assert.Nil(newPrefilter(HexColorRegex).delimiters, "nothing is required")
+}
+
+func TestPrefilter_FindAllIndex(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	regex := regexp.MustCompile(`\w+@\w+`)
+	p := newPrefilter(regex, "@")
+	text := "no address\njohn@example and jane@example\nnothing\n\nbob@example, x@y"
+
+	assert.Equal(regex.FindAllStringIndex(text, -1), p.findAllIndex(regex, text, -1))
+	assert.Equal(regex.FindAllStringIndex(text, 2), p.findAllIndex(regex, text, 2))
+	assert.Nil(p.findAllIndex(regex, "no address\nat all", -1))
+}
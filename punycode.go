@@ -0,0 +1,199 @@
+package commonregex
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// Punycode parameters from RFC 3492
+const (
+	punyBase        = 36
+	punyTMin        = 1
+	punyTMax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+	// punyMaxIndex bounds the decoder's state, well above what a label of
+	// 63 code points needs, so that it cannot overflow
+	punyMaxIndex = 1<<30 - 1
+	// acePrefix marks an internationalized label in its ASCII form
+	acePrefix = "xn--"
+)
+
+var errPunycode = errors.New("commonregex: invalid punycode")
+
+func punyAdapt(delta, numPoints int, first bool) int {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := 0
+	for delta > ((punyBase-punyTMin)*punyTMax)/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
+
+func punyThreshold(k, bias int) int {
+	switch {
+	case k <= bias:
+		return punyTMin
+	case k >= bias+punyTMax:
+		return punyTMax
+	}
+	return k - bias
+}
+
+func punyDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+// punycodeEncode encodes a label as punycode, without the ACE prefix
+func punycodeEncode(label string) string {
+	var out strings.Builder
+	var runes []rune
+	for _, r := range label {
+		runes = append(runes, r)
+		if r < utf8.RuneSelf {
+			out.WriteByte(byte(r))
+		}
+	}
+	basic := out.Len()
+	h := basic
+	if basic > 0 {
+		out.WriteByte('-')
+	}
+
+	n, delta, bias := punyInitialN, 0, punyInitialBias
+	for h < len(runes) {
+		m := rune(utf8.MaxRune)
+		for _, r := range runes {
+			if int(r) >= n && r < m {
+				m = r
+			}
+		}
+		delta += (int(m) - n) * (h + 1)
+		n = int(m)
+		for _, r := range runes {
+			if int(r) < n {
+				delta++
+			}
+			if int(r) != n {
+				continue
+			}
+			q := delta
+			for k := punyBase; ; k += punyBase {
+				t := punyThreshold(k, bias)
+				if q < t {
+					break
+				}
+				out.WriteByte(punyDigit(t + (q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			out.WriteByte(punyDigit(q))
+			bias = punyAdapt(delta, h+1, h == basic)
+			delta = 0
+			h++
+		}
+		delta++
+		n++
+	}
+	return out.String()
+}
+
+// punycodeDecode decodes a punycode label, without the ACE prefix
+func punycodeDecode(encoded string) (string, error) {
+	var runes []rune
+	rest := encoded
+	if i := strings.LastIndexByte(encoded, '-'); i >= 0 {
+		for _, r := range encoded[:i] {
+			if r >= utf8.RuneSelf {
+				return "", errPunycode
+			}
+			runes = append(runes, r)
+		}
+		rest = encoded[i+1:]
+	}
+
+	n, i, bias := punyInitialN, 0, punyInitialBias
+	for pos := 0; pos < len(rest); {
+		oldi, w := i, 1
+		for k := punyBase; ; k += punyBase {
+			if pos == len(rest) {
+				return "", errPunycode
+			}
+			var digit int
+			switch c := rest[pos]; {
+			case 'a' <= c && c <= 'z':
+				digit = int(c - 'a')
+			case 'A' <= c && c <= 'Z':
+				digit = int(c - 'A')
+			case '0' <= c && c <= '9':
+				digit = int(c-'0') + 26
+			default:
+				return "", errPunycode
+			}
+			pos++
+			if digit > (punyMaxIndex-i)/w {
+				return "", errPunycode
+			}
+			i += digit * w
+			t := punyThreshold(k, bias)
+			if digit < t {
+				break
+			}
+			if w > punyMaxIndex/(punyBase-t) {
+				return "", errPunycode
+			}
+			w *= punyBase - t
+		}
+		bias = punyAdapt(i-oldi, len(runes)+1, oldi == 0)
+		n += i / (len(runes) + 1)
+		i %= len(runes) + 1
+		if n > utf8.MaxRune {
+			return "", errPunycode
+		}
+		runes = append(runes, 0)
+		copy(runes[i+1:], runes[i:])
+		runes[i] = rune(n)
+		i++
+	}
+	return string(runes), nil
+}
+
+// hostToUnicode lowercases host and decodes its punycode labels
+func hostToUnicode(host string) (string, error) {
+	labels := strings.Split(strings.ToLower(host), ".")
+	for i, label := range labels {
+		if strings.HasPrefix(label, acePrefix) {
+			decoded, err := punycodeDecode(label[len(acePrefix):])
+			if err != nil {
+				return "", err
+			}
+			labels[i] = decoded
+		}
+	}
+	return strings.Join(labels, "."), nil
+}
+
+// hostToASCII lowercases host and encodes its non-ASCII labels as punycode
+func hostToASCII(host string) string {
+	labels := strings.Split(strings.ToLower(host), ".")
+	for i, label := range labels {
+		for _, r := range label {
+			if r >= utf8.RuneSelf {
+				labels[i] = acePrefix + punycodeEncode(label)
+				break
+			}
+		}
+	}
+	return strings.Join(labels, ".")
+}
@@ -0,0 +1,302 @@
+package commonregex
+
+import (
+	_ "embed" // for the unit table
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Number is a number found by FindNumbers
+type Number struct {
+	// Value is the number as written, like "-1,234.5" or "1.2e-3"
+	Value string
+	// Decimal is the exact number, like "-1234.5" or "0.0012"
+	Decimal Decimal
+	// Start and End are the offsets of the number in the text searched
+	Start, End int
+}
+
+// Percentage is a percentage found by FindPercentages
+type Percentage struct {
+	// Value is the percentage as written, like "15 %" or "2.5 percent"
+	Value string
+	// Percent is the number of percent, like "15" or "2.5"
+	Percent Decimal
+	// Start and End are the offsets of the percentage in the text searched
+	Start, End int
+}
+
+// Quantity is a measurement found by FindQuantities
+type Quantity struct {
+	// Value is the quantity as written, like "3.5 kilograms"
+	Value string
+	// Amount is the exact number of units, like "3.5"
+	Amount Decimal
+	// Unit is the symbol of the unit, like "kg", whichever way it is
+	// written
+	Unit string
+	// Dimension is what the unit measures, like "mass", "length", "speed"
+	// or "data"
+	Dimension string
+	// Start and End are the offsets of the quantity in the text searched
+	Start, End int
+}
+
+// maxExponentDigits bounds the digits of the exponents numbers are read with,
+// so that "1e999" does not become a thousand digits: "1e99" is the largest
+const maxExponentDigits = 2
+
+var (
+	numberRegexes   = make(map[numberSeparators]*regexp.Regexp)
+	numberRegexesMu sync.Mutex
+)
+
+// numberRegex returns the regular expression matching the numbers written
+// with seps. Its submatches are the sign, the whole part, the fraction and
+// the exponent.
+func numberRegex(seps numberSeparators) *regexp.Regexp {
+	numberRegexesMu.Lock()
+	defer numberRegexesMu.Unlock()
+	re, ok := numberRegexes[seps]
+	if !ok {
+		re = regexp.MustCompile(`([-+\x{2212}]?)(\d{1,3}(?:` + seps.group + `\d{3})+|\d+)(?:` + seps.point +
+			`(\d+))?(?:[eE]([-+]?\d{1,` + strconv.Itoa(maxExponentDigits) + `}))?`)
+		numberRegexes[seps] = re
+	}
+	return re
+}
+
+// numberMatch is a number found in a text by findNumbers
+type numberMatch struct {
+	start, end int
+	value      Decimal
+}
+
+// findNumbers finds the numbers written in text as the locale writes them.
+// Numbers joined to a word, like "mp3" or "4x4", and parts of dotted
+// sequences, like versions or IP addresses, are skipped, but not numbers with
+// a unit attached, like "3kg".
+func findNumbers(text string, locale Locale) []numberMatch {
+	var found []numberMatch
+	for _, sub := range numberRegex(locale.separators()).FindAllStringSubmatchIndex(text, -1) {
+		start, end := sub[0], sub[1]
+		if sub[3] > sub[2] && start > 0 && isNumberByte(text[start-1]) {
+			// a dash between numbers, as in a date, is not a minus sign
+			start = sub[3]
+		}
+		if prev, _ := utf8.DecodeLastRuneInString(text[:start]); start > 0 &&
+			(prev == '_' || unicode.IsLetter(prev) || unicode.IsDigit(prev)) {
+			continue
+		}
+		if start > 1 && (text[start-1] == '.' || text[start-1] == ',') && isNumberByte(text[start-2]) {
+			continue
+		}
+		if end+1 < len(text) && (text[end] == '.' || text[end] == ',') && isNumberByte(text[end+1]) {
+			continue
+		}
+		if next, _ := utf8.DecodeRuneInString(text[end:]); end < len(text) &&
+			(next == '_' || unicode.IsLetter(next) || unicode.IsDigit(next)) {
+			if _, _, ok := unitAt(text, end); !ok {
+				continue
+			}
+		}
+
+		sign := text[sub[2]:sub[3]]
+		whole := strings.Map(func(r rune) rune {
+			if r < '0' || r > '9' {
+				return -1
+			}
+			return r
+		}, text[sub[4]:sub[5]])
+		fraction := ""
+		if sub[6] >= 0 {
+			fraction = text[sub[6]:sub[7]]
+		}
+		exponent := 0
+		if sub[8] >= 0 {
+			exponent, _ = strconv.Atoi(strings.TrimPrefix(text[sub[8]:sub[9]], "+"))
+		}
+		negative := start == sub[2] && sign != "" && sign != "+"
+		found = append(found, numberMatch{start, end, newDecimal(negative, whole, fraction, exponent)})
+	}
+	return found
+}
+
+func isNumberByte(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+// unit is a unit of measurement of the unit table
+type unit struct {
+	symbol, dimension string
+}
+
+// unitTable lists the units Quantities knows
+//
+//go:embed data/units.txt
+var unitTable string
+
+var (
+	// unitSpellings are the ways units are written, the longest first
+	unitSpellings   []string
+	unitsBySpelling map[string]unit
+	unitsOnce       sync.Once
+)
+
+// loadUnits parses the unit table on first use
+func loadUnits() {
+	unitsBySpelling = make(map[string]unit)
+	for _, line := range strings.Split(unitTable, "\n") {
+		fields := strings.Split(line, "|")
+		if len(fields) != 3 || strings.HasPrefix(line, "//") {
+			continue
+		}
+		u := unit{symbol: strings.TrimSpace(fields[0]), dimension: strings.TrimSpace(fields[1])}
+		for _, spelling := range strings.Split(fields[2], ",") {
+			spelling = strings.TrimSpace(spelling)
+			if _, ok := unitsBySpelling[spelling]; !ok {
+				unitSpellings = append(unitSpellings, spelling)
+			}
+			unitsBySpelling[spelling] = u
+		}
+	}
+	sort.SliceStable(unitSpellings, func(i, j int) bool {
+		return len(unitSpellings[i]) > len(unitSpellings[j])
+	})
+}
+
+// unitAt returns the unit written at text[i:], after at most one space, and
+// where it ends. The unit must not run on into a word, so "5 mines" has none.
+func unitAt(text string, i int) (unit, int, bool) {
+	unitsOnce.Do(loadUnits)
+	if r, size := utf8.DecodeRuneInString(text[i:]); r == ' ' || r == '\u00a0' || r == '\u202f' {
+		i += size
+	}
+	for _, spelling := range unitSpellings {
+		if !strings.HasPrefix(text[i:], spelling) {
+			continue
+		}
+		end := i + len(spelling)
+		if next, _ := utf8.DecodeRuneInString(text[end:]); end < len(text) &&
+			(next == '_' || unicode.IsLetter(next) || unicode.IsDigit(next)) {
+			continue
+		}
+		return unitsBySpelling[spelling], end, true
+	}
+	return unit{}, 0, false
+}
+
+// percentSignRegex matches the percent sign or word after a number
+var percentSignRegex = regexp.MustCompile(`^[ \x{00A0}\x{202F}]?(?:%|percent\b|per cent\b|pct\b)`)
+
+// FindNumbers finds the numbers in text, with an optional sign, thousands
+// separators, fraction and exponent, and reads them exactly. Numbers are
+// written as in English, like "-1,234.5" or "1.2e-3", unless another locale
+// is given with InLocale. The Limit, Unique, CaseFold, SortByFrequency,
+// Normalized, Deobfuscated and InLocale options apply.
+func FindNumbers(text string, opts ...Option) []Number {
+	o := newOptions(opts)
+	m := o.prepare(text)
+	prepared := m.String()
+
+	var found []Number
+	var values []string
+	for _, n := range findNumbers(prepared, o.locale) {
+		value := prepared[n.start:n.end]
+		start, end := m.span(n.start, n.end)
+		found = append(found, Number{Value: value, Decimal: n.value, Start: start, End: end})
+		values = append(values, value)
+	}
+
+	return keepFound(o, found, values)
+}
+
+// Numbers returns the numbers in text as written, like FindNumbers
+func Numbers(text string, opts ...Option) []string {
+	var values []string
+	for _, n := range FindNumbers(text, opts...) {
+		values = append(values, n.Value)
+	}
+	return values
+}
+
+// FindPercentages finds the numbers in text followed by a percent sign or
+// the word "percent", like "15%", "15 %" or "2.5 percent". Numbers are read
+// as FindNumbers reads them, and the same options apply.
+func FindPercentages(text string, opts ...Option) []Percentage {
+	o := newOptions(opts)
+	m := o.prepare(text)
+	prepared := m.String()
+
+	var found []Percentage
+	var values []string
+	for _, n := range findNumbers(prepared, o.locale) {
+		loc := percentSignRegex.FindStringIndex(prepared[n.end:])
+		if loc == nil {
+			continue
+		}
+		value := prepared[n.start : n.end+loc[1]]
+		start, end := m.span(n.start, n.end+loc[1])
+		found = append(found, Percentage{Value: value, Percent: n.value, Start: start, End: end})
+		values = append(values, value)
+	}
+
+	return keepFound(o, found, values)
+}
+
+// Percentages returns the percentages in text as written, like
+// FindPercentages
+func Percentages(text string, opts ...Option) []string {
+	var values []string
+	for _, p := range FindPercentages(text, opts...) {
+		values = append(values, p.Value)
+	}
+	return values
+}
+
+// FindQuantities finds the numbers in text followed by a unit of the
+// embedded unit table, like "3.5 kg", "120 km/h", "2TB" or "6 feet". Metric,
+// imperial and US customary units and data sizes are known, each reported
+// with one symbol however it is written. Numbers are read as FindNumbers
+// reads them, and the same options apply.
+func FindQuantities(text string, opts ...Option) []Quantity {
+	o := newOptions(opts)
+	m := o.prepare(text)
+	prepared := m.String()
+
+	var found []Quantity
+	var values []string
+	for _, n := range findNumbers(prepared, o.locale) {
+		u, unitEnd, ok := unitAt(prepared, n.end)
+		if !ok {
+			continue
+		}
+		value := prepared[n.start:unitEnd]
+		start, end := m.span(n.start, unitEnd)
+		found = append(found, Quantity{
+			Value:     value,
+			Amount:    n.value,
+			Unit:      u.symbol,
+			Dimension: u.dimension,
+			Start:     start,
+			End:       end,
+		})
+		values = append(values, value)
+	}
+
+	return keepFound(o, found, values)
+}
+
+// Quantities returns the quantities in text as written, like FindQuantities
+func Quantities(text string, opts ...Option) []string {
+	var values []string
+	for _, q := range FindQuantities(text, opts...) {
+		values = append(values, q.Value)
+	}
+	return values
+}
@@ -0,0 +1,188 @@
+package commonregex
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuantity_FindNumbers(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []struct {
+		text    string
+		value   string
+		decimal Decimal
+	}{
+		{"42", "42", "42"},
+		{"it costs -1,234.5 now", "-1,234.5", "-1234.5"},
+		{"+7 degrees", "+7", "7"},
+		{"−3.25", "−3.25", "-3.25"},
+		{"rate 1.2e-3", "1.2e-3", "0.0012"},
+		{"about 6.02E+23", "6.02E+23", "602000000000000000000000"},
+		{"1e99", "1e99", Decimal("1" + strings.Repeat("0", 99))},
+		{"2.5e2 items", "2.5e2", "250"},
+		{"0007 agents", "0007", "7"},
+		{"a total of 1,234,567.89.", "1,234,567.89", "1234567.89"},
+		{"weighs 3kg", "3", "3"},
+	}
+
+	for _, test := range tests {
+		found := FindNumbers(test.text)
+		if assert.Len(found, 1, "numbers in %q", test.text) {
+			assert.Equal(test.value, found[0].Value)
+			assert.Equal(test.value, test.text[found[0].Start:found[0].End])
+			assert.Equal(string(test.decimal), string(found[0].Decimal), "decimal of %q", test.value)
+		}
+	}
+
+	failingTests := []string{
+		"mp3",
+		"4x4",
+		"version 1.2.3",
+		"ping 10.0.0.1",
+		"id_42",
+		"the 1st and 2nd",
+		"5k",
+		"1e999",
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(Numbers(test), "no numbers in %q", test)
+	}
+
+	assert.Equal([]string{"2017", "03", "23"}, Numbers("on 2017-03-23"))
+	assert.Equal([]string{"3", "-4"}, Numbers("3 and -4"))
+	assert.Equal([]string{"1", "2"}, Numbers("1 2 1", Unique()))
+	assert.Len(Numbers("1 2 3", Limit(2)), 2)
+}
+
+func TestQuantity_FindPercentages(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := "Sales rose 15% while costs fell 2.5 percent, a -3 % swing and 12 pct at 100 percentile."
+
+	tests := []struct {
+		value   string
+		percent Decimal
+	}{
+		{"15%", "15"},
+		{"2.5 percent", "2.5"},
+		{"-3 %", "-3"},
+		{"12 pct", "12"},
+	}
+
+	found := FindPercentages(text)
+	if assert.Len(found, len(tests)) {
+		for i, test := range tests {
+			assert.Equal(test.value, found[i].Value)
+			assert.Equal(test.value, text[found[i].Start:found[i].End])
+			assert.Equal(string(test.percent), string(found[i].Percent))
+		}
+	}
+
+	assert.Equal([]string{"12,5 %"}, Percentages("une hausse de 12,5 %", InLocale(LocaleFrench)))
+	assert.Empty(Percentages("15 people"))
+}
+
+func TestQuantity_FindQuantities(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []struct {
+		text      string
+		value     string
+		amount    Decimal
+		unit      string
+		dimension string
+	}{
+		{"weighs 3.5 kg", "3.5 kg", "3.5", "kg", "mass"},
+		{"weighs 3.5 kilograms", "3.5 kilograms", "3.5", "kg", "mass"},
+		{"weighs 3kg", "3kg", "3", "kg", "mass"},
+		{"top speed 120 km/h", "120 km/h", "120", "km/h", "speed"},
+		{"a 2 TB disk", "2 TB", "2", "TB", "data"},
+		{"at 100 Mbps", "100 Mbps", "100", "Mbit/s", "data rate"},
+		{"copies at 100 MB/s", "100 MB/s", "100", "MB/s", "data rate"},
+		{"a 27″ screen", "27″", "27", "in", "length"},
+		{"6 feet tall", "6 feet", "6", "ft", "length"},
+		{"12 fl oz of water", "12 fl oz", "12", "fl oz", "volume"},
+		{"outside it is -4 °C", "-4 °C", "-4", "°C", "temperature"},
+		{"ran 5 mi", "5 mi", "5", "mi", "length"},
+		{"drink 1.5 l", "1.5 l", "1.5", "l", "volume"},
+	}
+
+	for _, test := range tests {
+		found := FindQuantities(test.text)
+		if assert.Len(found, 1, "quantities in %q", test.text) {
+			assert.Equal(test.value, found[0].Value)
+			assert.Equal(test.value, test.text[found[0].Start:found[0].End])
+			assert.Equal(string(test.amount), string(found[0].Amount), "amount of %q", test.value)
+			assert.Equal(test.unit, found[0].Unit, "unit of %q", test.value)
+			assert.Equal(test.dimension, found[0].Dimension, "dimension of %q", test.value)
+		}
+	}
+
+	failingTests := []string{
+		"5 mines",
+		"3 kgs of",
+		"we are 2 in a team",
+		"15%",
+		`He said "42"`,
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(Quantities(test), "no quantities in %q", test)
+	}
+}
+
+func TestQuantity_InLocale(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []struct {
+		locale  Locale
+		text    string
+		decimal Decimal
+	}{
+		{LocaleEnglish, "1,234.5", "1234.5"},
+		{LocaleGerman, "1.234,5", "1234.5"},
+		{"de-DE", "1.234,5", "1234.5"},
+		{"de_AT", "1.234,5", "1234.5"},
+		{LocaleFrench, "1 234,5", "1234.5"},
+		{LocaleFrench, "1 234,5", "1234.5"},
+		{LocaleFrench, "1 234,5", "1234.5"},
+		{LocaleSpanish, "-0,25", "-0.25"},
+		{LocaleJapanese, "1,234.5", "1234.5"},
+		{"xx", "1,234.5", "1234.5"},
+	}
+
+	for _, test := range tests {
+		found := FindNumbers(test.text, InLocale(test.locale))
+		if assert.Len(found, 1, "numbers in %q in %s", test.text, test.locale) {
+			assert.Equal(test.text, found[0].Value)
+			assert.Equal(string(test.decimal), string(found[0].Decimal), "decimal of %q in %s", test.text, test.locale)
+		}
+	}
+
+	assert.Equal([]string{"3,5 kg"}, Quantities("3,5 kg Mehl", InLocale(LocaleGerman)))
+	assert.Empty(Numbers("3,5", InLocale(LocaleEnglish)), "3,5 is neither 3.5 nor 35 in English")
+}
+
+func TestQuantity_UnitTable(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	unitsOnce.Do(loadUnits)
+	assert.NotEmpty(unitSpellings)
+	for i := 1; i < len(unitSpellings); i++ {
+		assert.True(len(unitSpellings[i-1]) >= len(unitSpellings[i]), "%q should come before %q", unitSpellings[i-1], unitSpellings[i])
+	}
+	for spelling, u := range unitsBySpelling {
+		assert.NotEmpty(spelling)
+		assert.NotEmpty(u.symbol, "symbol of %q", spelling)
+		assert.NotEmpty(u.dimension, "dimension of %q", spelling)
+	}
+}
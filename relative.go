@@ -0,0 +1,342 @@
+package commonregex
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// RelativeTime is a duration or a time relative to now found by
+// FindRelativeTimes
+type RelativeTime struct {
+	// Value is the expression as written, like "in 2 hours" or "PT15M"
+	Value string
+	// Duration is how far Time is from the reference, negative if it is
+	// before. A month or a year is as long as the one it spans.
+	Duration time.Duration
+	// Time is the time the expression refers to, from the reference given
+	// with RelativeTo or from the time of the search
+	Time time.Time
+	// Start and End are the offsets of the expression in the text searched
+	Start, End int
+}
+
+// RelativeTo makes FindRelativeTimes and RelativeTimes anchor the times they
+// find to ref instead of the time of the search, and FindTimestamps take the
+// year of syslog timestamps from it. Other finders ignore it.
+func RelativeTo(ref time.Time) Option {
+	return func(o *options) {
+		o.reference = ref
+	}
+}
+
+// relativeAmountPattern matches the amount of an English duration term
+const relativeAmountPattern = `\d+(?:\.\d+)?|an?|one|two|three|four|five|six|seven|eight|nine|ten|eleven|twelve|fifteen|twenty|thirty|a\s+couple\s+of|half\s+an?`
+
+// relativeUnitPattern matches the unit of an English duration term
+const relativeUnitPattern = `seconds?|secs?|minutes?|mins?|hours?|hrs?|days?|weeks?|fortnights?|months?|years?|yrs?`
+
+// relativeTermsPattern matches English duration terms, like "2 hours and 30
+// minutes"
+const relativeTermsPattern = `(?:` + relativeAmountPattern + `)\s+(?:` + relativeUnitPattern + `)` +
+	`(?:(?:\s+and\s+|\s+)(?:` + relativeAmountPattern + `)\s+(?:` + relativeUnitPattern + `))*`
+
+const relativeWeekdayPattern = `mondays?|mon|tuesdays?|tues?|wednesdays?|wed|thursdays?|thu|thur|thurs|fridays?|fri|saturdays?|sat|sundays?|sun`
+
+var (
+	// goDurationRegex matches durations as time.ParseDuration reads them
+	goDurationRegex = regexp.MustCompile(`-?(?:\d+(?:\.\d+)?(?:ns|us|µs|μs|ms|h|m|s))+`)
+	// isoDurationRegex matches ISO 8601 durations, whose submatches are the
+	// years, months, weeks, days, hours, minutes and seconds
+	isoDurationRegex = regexp.MustCompile(`\bP(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)S)?)?\b`)
+	// relativePhraseRegex matches the English expressions, whose submatches
+	// are the terms of "in ..." or of "... ago", the direction of the latter,
+	// the terms of a bare duration, the word for a day, the direction and
+	// weekday of "next Tuesday", and the direction and unit of "last week"
+	relativePhraseRegex = regexp.MustCompile(`(?i)\b(?:in\s+(` + relativeTermsPattern + `)|(` + relativeTermsPattern + `)\s+(ago|from\s+now|later|earlier)|` +
+		// the first term of a bare duration may not be "a" or "an", so that "a
+		// second opinion" is not one
+		`((?:\d+(?:\.\d+)?|one|two|three|four|five|six|seven|eight|nine|ten|eleven|twelve|fifteen|twenty|thirty)\s+(?:` + relativeUnitPattern + `)` +
+		`(?:(?:\s+and\s+|\s+)(?:` + relativeAmountPattern + `)\s+(?:` + relativeUnitPattern + `))*)|` +
+		`(the\s+day\s+after\s+tomorrow|the\s+day\s+before\s+yesterday|today|tomorrow|yesterday)|` +
+		`(next|last|this|coming|past)\s+(` + relativeWeekdayPattern + `)|(next|last)\s+(week|month|year))\b`)
+	// decadeRegex matches the decades, like "1990s" or "80s", which are not
+	// durations. The short ones are decades only after the text matched by
+	// decadeLeadRegex, so that "retry in 30s" keeps its duration.
+	decadeRegex       = regexp.MustCompile(`^(?:([12]\d)|)\d0s$`)
+	decadeLeadRegex   = regexp.MustCompile(`(?i)(?:\bthe\s+|['’]|\d0s(?:\s*,\s*|\s+(?:and|or|to)\s+))$`)
+	relativeTermRegex = regexp.MustCompile(`(?i)(` + relativeAmountPattern + `)\s+(` + relativeUnitPattern + `)`)
+)
+
+// relativeOffset is an offset in calendar units and clock time
+type relativeOffset struct {
+	years, months, days int
+	clock               time.Duration
+}
+
+// from returns ref moved by the offset, forwards or backwards by sign
+func (off relativeOffset) from(ref time.Time, sign int) time.Time {
+	return ref.AddDate(sign*off.years, sign*off.months, sign*off.days).Add(time.Duration(sign) * off.clock)
+}
+
+// relativeNumbers are the amounts written in words
+var relativeNumbers = map[string]float64{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+	"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+	"fifteen": 15, "twenty": 20, "thirty": 30, "a couple of": 2, "half a": 0.5, "half an": 0.5,
+}
+
+// parseRelativeTerms adds up English duration terms, like "1 hour and 30
+// minutes". Fractions of a month or a year count 30 or 365 days.
+func parseRelativeTerms(terms string) relativeOffset {
+	var off relativeOffset
+	for _, sub := range relativeTermRegex.FindAllStringSubmatch(terms, -1) {
+		word := strings.ToLower(strings.Join(strings.Fields(sub[1]), " "))
+		amount, ok := relativeNumbers[word]
+		if !ok {
+			amount, _ = strconv.ParseFloat(word, 64)
+		}
+		unit := strings.ToLower(sub[2])
+		switch {
+		case strings.HasPrefix(unit, "s"):
+			off.clock += time.Duration(amount * float64(time.Second))
+		case strings.HasPrefix(unit, "mi"):
+			off.clock += time.Duration(amount * float64(time.Minute))
+		case strings.HasPrefix(unit, "h"):
+			off.clock += time.Duration(amount * float64(time.Hour))
+		case strings.HasPrefix(unit, "d"):
+			off.addDays(amount)
+		case strings.HasPrefix(unit, "w"):
+			off.addDays(amount * 7)
+		case strings.HasPrefix(unit, "f"):
+			off.addDays(amount * 14)
+		case strings.HasPrefix(unit, "mo"):
+			whole := int(amount)
+			off.months += whole
+			off.addDays((amount - float64(whole)) * 30)
+		case strings.HasPrefix(unit, "y"):
+			whole := int(amount)
+			off.years += whole
+			off.addDays((amount - float64(whole)) * 365)
+		}
+	}
+	return off
+}
+
+// addDays adds whole calendar days and the fraction of a day as clock time
+func (off *relativeOffset) addDays(days float64) {
+	whole := int(days)
+	off.days += whole
+	off.clock += time.Duration((days - float64(whole)) * float64(24*time.Hour))
+}
+
+// parseISODuration returns the offset of the ISO 8601 duration matched by
+// isoDurationRegex, given its submatches
+func parseISODuration(sub []string) relativeOffset {
+	field := func(i int) int {
+		n, _ := strconv.Atoi(sub[i])
+		return n
+	}
+	seconds, _ := strconv.ParseFloat(strings.Replace(sub[7], ",", ".", 1), 64)
+	return relativeOffset{
+		years:  field(1),
+		months: field(2),
+		days:   field(3)*7 + field(4),
+		clock: time.Duration(field(5))*time.Hour + time.Duration(field(6))*time.Minute +
+			time.Duration(seconds*float64(time.Second)),
+	}
+}
+
+// relativeWeekdays are the weekdays by the first three letters of their names
+var relativeWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// relativeDays returns how many days from the reference day the English
+// expression matched by relativePhraseRegex refers to, given its submatches
+func relativeDays(ref time.Time, sub []string) int {
+	if day := strings.ToLower(strings.Join(strings.Fields(sub[5]), " ")); day != "" {
+		return map[string]int{
+			"the day before yesterday": -2, "yesterday": -1, "today": 0,
+			"tomorrow": 1, "the day after tomorrow": 2,
+		}[day]
+	}
+	weekday := relativeWeekdays[strings.ToLower(sub[7][:3])]
+	switch strings.ToLower(sub[6]) {
+	case "next", "coming":
+		return (int(weekday-ref.Weekday())+6)%7 + 1
+	case "last", "past":
+		return -((int(ref.Weekday()-weekday)+6)%7 + 1)
+	}
+	return (int(weekday-ref.Weekday()) + 7) % 7
+}
+
+// relativeMatch is an expression found by findRelativeTimes
+type relativeMatch struct {
+	start, end int
+	at         time.Time
+}
+
+// findRelativeTimes finds the durations and relative times in text, anchored
+// to ref, the longest first where they overlap
+// isDecade reports whether text[start:end] is a decade, like "1990s", or
+// "80s" in "the 80s".
+func isDecade(text string, start, end int) bool {
+	sub := decadeRegex.FindStringSubmatchIndex(text[start:end])
+	if sub == nil {
+		return false
+	}
+	if sub[2] < sub[3] {
+		return true
+	}
+	lead := start - 12
+	if lead < 0 {
+		lead = 0
+	}
+	return decadeLeadRegex.MatchString(text[lead:start])
+}
+
+func findRelativeTimes(text string, ref time.Time) []relativeMatch {
+	var found []relativeMatch
+	for _, loc := range goDurationRegex.FindAllStringIndex(text, -1) {
+		if prev, _ := utf8.DecodeLastRuneInString(text[:loc[0]]); loc[0] > 0 &&
+			(prev == '_' || prev == '.' || unicode.IsLetter(prev) || unicode.IsDigit(prev)) {
+			continue
+		}
+		if next, _ := utf8.DecodeRuneInString(text[loc[1]:]); loc[1] < len(text) &&
+			(next == '_' || unicode.IsLetter(next) || unicode.IsDigit(next)) {
+			continue
+		}
+		d, err := time.ParseDuration(text[loc[0]:loc[1]])
+		if err != nil || isDecade(text, loc[0], loc[1]) {
+			continue
+		}
+		found = append(found, relativeMatch{loc[0], loc[1], ref.Add(d)})
+	}
+	for _, sub := range isoDurationRegex.FindAllStringSubmatchIndex(text, -1) {
+		value := text[sub[0]:sub[1]]
+		if value == "P" || strings.HasSuffix(value, "T") {
+			continue
+		}
+		groups := make([]string, 8)
+		for i := 1; i < 8; i++ {
+			if sub[2*i] >= 0 {
+				groups[i] = text[sub[2*i]:sub[2*i+1]]
+			}
+		}
+		found = append(found, relativeMatch{sub[0], sub[1], parseISODuration(groups).from(ref, 1)})
+	}
+	midnight := time.Date(ref.Year(), ref.Month(), ref.Day(), 0, 0, 0, 0, ref.Location())
+	for _, loc := range relativePhraseRegex.FindAllStringSubmatchIndex(text, -1) {
+		sub := make([]string, len(loc)/2)
+		for i := range sub {
+			if loc[2*i] >= 0 {
+				sub[i] = text[loc[2*i]:loc[2*i+1]]
+			}
+		}
+		var at time.Time
+		switch {
+		case sub[1] != "":
+			at = parseRelativeTerms(sub[1]).from(ref, 1)
+		case sub[2] != "":
+			sign := 1
+			if direction := strings.ToLower(sub[3]); direction == "ago" || direction == "earlier" {
+				sign = -1
+			}
+			at = parseRelativeTerms(sub[2]).from(ref, sign)
+		case sub[4] != "":
+			at = parseRelativeTerms(sub[4]).from(ref, 1)
+		case sub[5] != "" || sub[7] != "":
+			at = midnight.AddDate(0, 0, relativeDays(ref, sub))
+		default:
+			sign := 1
+			if strings.EqualFold(sub[8], "last") {
+				sign = -1
+			}
+			at = map[string]relativeOffset{
+				"week": {days: 7}, "month": {months: 1}, "year": {years: 1},
+			}[strings.ToLower(sub[9])].from(ref, sign)
+		}
+		found = append(found, relativeMatch{loc[0], loc[1], at})
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		if found[i].start != found[j].start {
+			return found[i].start < found[j].start
+		}
+		return found[i].end > found[j].end
+	})
+	var kept []relativeMatch
+	end := 0
+	for _, match := range found {
+		if match.start < end {
+			continue
+		}
+		end = match.end
+		kept = append(kept, match)
+	}
+	return kept
+}
+
+// FindRelativeTimes finds the durations and the times relative to now in
+// text, in the order they appear:
+//
+//   - durations as time.ParseDuration reads them, like "1h30m" or "-5m"
+//   - ISO 8601 durations, like "PT15M" or "P1Y2M10D"
+//   - English durations, like "2 hours and 30 minutes", "in 2 hours", "3
+//     days ago" or "a week from now"
+//   - English days, like "tomorrow", "next Tuesday", "last Friday" or "next
+//     month"
+//
+// A bare duration is taken to be from the reference on, and expressions too
+// far from it for a time.Duration are skipped. Decades, like "1990s" or "the
+// 80s", are not durations. Days are anchored to the midnight starting them,
+// in the location of the reference. The reference is the time of the search,
+// or the time given with RelativeTo.
+// The Limit, Unique, CaseFold, SortByFrequency, Normalized, Deobfuscated and
+// RelativeTo options apply.
+func FindRelativeTimes(text string, opts ...Option) []RelativeTime {
+	o := newOptions(opts)
+	m := o.prepare(text)
+	prepared := m.String()
+	ref := o.reference
+	if ref.IsZero() {
+		ref = time.Now()
+	}
+
+	var found []RelativeTime
+	var values []string
+	for _, match := range findRelativeTimes(prepared, ref) {
+		if !ref.Add(match.at.Sub(ref)).Equal(match.at) {
+			// too far away for a time.Duration
+			continue
+		}
+		value := prepared[match.start:match.end]
+		start, end := m.span(match.start, match.end)
+		found = append(found, RelativeTime{
+			Value:    value,
+			Duration: match.at.Sub(ref),
+			Time:     match.at,
+			Start:    start,
+			End:      end,
+		})
+		values = append(values, value)
+	}
+
+	return keepFound(o, found, values)
+}
+
+// RelativeTimes returns the durations and relative times in text as written,
+// like FindRelativeTimes
+func RelativeTimes(text string, opts ...Option) []string {
+	var values []string
+	for _, r := range FindRelativeTimes(text, opts...) {
+		values = append(values, r.Value)
+	}
+	return values
+}
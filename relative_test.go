@@ -0,0 +1,108 @@
+package commonregex
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// relativeRef is a Wednesday afternoon
+var relativeRef = time.Date(2026, 10, 21, 15, 4, 0, 0, time.UTC)
+
+func TestRelativeTime_FindRelativeTimes(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2026, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		text  string
+		value string
+		at    time.Time
+	}{
+		{"timeout is 1h30m", "1h30m", relativeRef.Add(90 * time.Minute)},
+		{"back off 250ms", "250ms", relativeRef.Add(250 * time.Millisecond)},
+		{"wait 45s", "45s", relativeRef.Add(45 * time.Second)},
+		{"poll every 1m30s", "1m30s", relativeRef.Add(90 * time.Second)},
+		{"retry in 30s", "30s", relativeRef.Add(30 * time.Second)},
+		{"skew of -5m", "-5m", relativeRef.Add(-5 * time.Minute)},
+		{"retry after PT15M", "PT15M", relativeRef.Add(15 * time.Minute)},
+		{"expires P1Y2M10DT2H30M", "P1Y2M10DT2H30M", time.Date(2027, 12, 31, 17, 34, 0, 0, time.UTC)},
+		{"lease of PT0,5S", "PT0,5S", relativeRef.Add(500 * time.Millisecond)},
+		{"deploy in 2 hours", "in 2 hours", relativeRef.Add(2 * time.Hour)},
+		{"paged 3 days ago", "3 days ago", relativeRef.AddDate(0, 0, -3)},
+		{"back in half an hour", "in half an hour", relativeRef.Add(30 * time.Minute)},
+		{"ready a week from now", "a week from now", relativeRef.AddDate(0, 0, 7)},
+		{"ran for 2 hours and 30 minutes", "2 hours and 30 minutes", relativeRef.Add(150 * time.Minute)},
+		{"rotate keys In Two Weeks", "In Two Weeks", relativeRef.AddDate(0, 0, 14)},
+		{"ten minutes later", "ten minutes later", relativeRef.Add(10 * time.Minute)},
+		{"due 1.5 days from now", "1.5 days from now", relativeRef.Add(36 * time.Hour)},
+		{"renew in 1 month", "in 1 month", relativeRef.AddDate(0, 1, 0)},
+		{"shipped yesterday", "yesterday", day(10, 20)},
+		{"due tomorrow", "tomorrow", day(10, 22)},
+		{"the day after tomorrow", "the day after tomorrow", day(10, 23)},
+		{"meet next Tuesday", "next Tuesday", day(10, 27)},
+		{"meet next Wednesday", "next Wednesday", day(10, 28)},
+		{"since last Friday", "last Friday", day(10, 16)},
+		{"since last Wed", "last Wed", day(10, 14)},
+		{"this Wednesday", "this Wednesday", day(10, 21)},
+		{"this Monday", "this Monday", day(10, 26)},
+		{"release next month", "next month", relativeRef.AddDate(0, 1, 0)},
+		{"freeze last week", "last week", relativeRef.AddDate(0, 0, -7)},
+	}
+
+	for _, test := range tests {
+		found := FindRelativeTimes(test.text, RelativeTo(relativeRef))
+		if assert.Len(found, 1, "relative times in %q", test.text) {
+			assert.Equal(test.value, found[0].Value)
+			assert.Equal(test.value, test.text[found[0].Start:found[0].End])
+			assert.True(test.at.Equal(found[0].Time), "%q should be %v, not %v", test.value, test.at, found[0].Time)
+			assert.Equal(test.at.Sub(relativeRef), found[0].Duration, "duration of %q", test.value)
+		}
+	}
+
+	failingTests := []string{
+		"a second opinion",
+		"the 1990s",
+		"music from the 80s and 90s",
+		"the '60s",
+		"v1.2h",
+		"x10m",
+		"PT",
+		"APT15M",
+		"in 2014",
+		"last night",
+		"10 mins2",
+		"P99999Y",
+	}
+
+	for _, test := range failingTests {
+		assert.Empty(RelativeTimes(test, RelativeTo(relativeRef)), "no relative times in %q", test)
+	}
+}
+
+func TestRelativeTime_Options(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := "in 2 hours, in 2 hours, 3 days ago and PT15M"
+	assert.Equal([]string{"in 2 hours", "in 2 hours", "3 days ago", "PT15M"}, RelativeTimes(text))
+	assert.Equal([]string{"in 2 hours", "3 days ago", "PT15M"}, RelativeTimes(text, Unique()))
+	assert.Len(RelativeTimes(text, Limit(2)), 2)
+
+	before := time.Now()
+	found := FindRelativeTimes("in 1 hour")
+	if assert.Len(found, 1) {
+		assert.Equal(time.Hour, found[0].Duration)
+		assert.False(found[0].Time.Before(before.Add(time.Hour)), "without RelativeTo, the reference is now")
+	}
+
+	// days are anchored in the location of the reference
+	tokyo := time.FixedZone("JST", 9*60*60)
+	found = FindRelativeTimes("tomorrow", RelativeTo(relativeRef.In(tokyo)))
+	if assert.Len(found, 1) {
+		assert.True(time.Date(2026, 10, 23, 0, 0, 0, 0, tokyo).Equal(found[0].Time), "got %v", found[0].Time)
+	}
+}
@@ -0,0 +1,344 @@
+package commonregex
+
+import (
+	"regexp"
+	"sort"
+)
+
+// Kind identifies one of the built-in patterns
+type Kind string
+
+// Built-in kinds
+const (
+	KindDate           Kind = "date"
+	KindTime           Kind = "time"
+	KindTimestamp      Kind = "timestamp"
+	KindEpochTime      Kind = "epoch_time"
+	KindPhone          Kind = "phone"
+	KindPhoneWithExt   Kind = "phone_with_ext"
+	KindLink           Kind = "link"
+	KindEmail          Kind = "email"
+	KindIPv4           Kind = "ipv4"
+	KindIPv6           Kind = "ipv6"
+	KindIP             Kind = "ip"
+	KindNotKnownPort   Kind = "not_known_port"
+	KindPrice          Kind = "price"
+	KindHexColor       Kind = "hex_color"
+	KindCreditCard     Kind = "credit_card"
+	KindBtcAddress     Kind = "btc_address"
+	KindEthAddress     Kind = "eth_address"
+	KindLtcAddress     Kind = "ltc_address"
+	KindBchAddress     Kind = "bch_address"
+	KindDogeAddress    Kind = "doge_address"
+	KindXmrAddress     Kind = "xmr_address"
+	KindSolAddress     Kind = "sol_address"
+	KindTrxAddress     Kind = "trx_address"
+	KindXrpAddress     Kind = "xrp_address"
+	KindStreetAddress  Kind = "street_address"
+	KindZipCode        Kind = "zip_code"
+	KindPoBox          Kind = "po_box"
+	KindSSN            Kind = "ssn"
+	KindNINO           Kind = "nino"
+	KindSIN            Kind = "sin"
+	KindNIR            Kind = "nir"
+	KindSteuerID       Kind = "steuer_id"
+	KindFiscalCode     Kind = "fiscal_code"
+	KindDNI            Kind = "dni"
+	KindNIE            Kind = "nie"
+	KindBSN            Kind = "bsn"
+	KindAadhaar        Kind = "aadhaar"
+	KindPAN            Kind = "pan"
+	KindCPF            Kind = "cpf"
+	KindCNPJ           Kind = "cnpj"
+	KindRRN            Kind = "rrn"
+	KindPassport       Kind = "passport"
+	KindDriversLicense Kind = "drivers_license"
+	KindEIN            Kind = "ein"
+	KindITIN           Kind = "itin"
+	KindMD5Hex         Kind = "md5_hex"
+	KindSHA1Hex        Kind = "sha1_hex"
+	KindSHA256Hex      Kind = "sha256_hex"
+	KindGUID           Kind = "guid"
+	KindISBN13         Kind = "isbn13"
+	KindISBN10         Kind = "isbn10"
+	KindVISACreditCard Kind = "visa_credit_card"
+	KindMCCreditCard   Kind = "mc_credit_card"
+	KindMACAddress     Kind = "mac_address"
+	KindIBAN           Kind = "iban"
+	KindGitRepo        Kind = "git_repo"
+)
+
+// kindInfo describes how a kind is matched
+type kindInfo struct {
+	kind   Kind
+	regex  *regexp.Regexp
+	filter prefilter
+	// context, if set, gates the kind: a match only counts with a match of
+	// context within contextWindow bytes of it
+	context *regexp.Regexp
+	// check, if set, rejects the matches text[start:end] it reports false
+	// for, like those the regular expression cannot rule out on its own
+	check func(text string, start, end int) bool
+}
+
+func newKind(kind Kind, regex *regexp.Regexp, required ...string) kindInfo {
+	return kindInfo{kind: kind, regex: regex, filter: newPrefilter(regex, required...)}
+}
+
+// newGatedKind returns a kind whose matches only count near a keyword matched
+// by context
+func newGatedKind(kind Kind, regex, context *regexp.Regexp, required ...string) kindInfo {
+	info := newKind(kind, regex, required...)
+	info.context = context
+	return info
+}
+
+// withCheck returns the kind with its matches only counting if check reports
+// true for them
+func (info kindInfo) withCheck(check func(text string, start, end int) bool) kindInfo {
+	info.check = check
+	return info
+}
+
+// withFind returns the kind with its matches found by find, as described on
+// prefilter
+func (info kindInfo) withFind(find func(text string, n int) [][]int) kindInfo {
+	info.filter.find = find
+	return info
+}
+
+// validMatch returns a check passing the matches valid reports true for, such
+// as the addresses with a valid checksum
+func validMatch(valid func(string) bool) func(text string, start, end int) bool {
+	return func(text string, start, end int) bool {
+		return valid(text[start:end])
+	}
+}
+
+// contextWindow is how many bytes before or after a match of a context-gated
+// kind its keyword may be
+const contextWindow = 48
+
+// findAllIndex returns the offsets of up to n matches of the kind in text, or
+// of all matches if n is negative
+func (info kindInfo) findAllIndex(text string, n int) [][]int {
+	if info.context == nil && info.check == nil {
+		return info.filter.findAllIndex(info.regex, text, n)
+	}
+	if info.context != nil && !info.context.MatchString(text) {
+		return nil
+	}
+	return info.gate(text, info.filter.findAllIndex(info.regex, text, -1), n)
+}
+
+// gated reports whether matches of the kind have to pass gate
+func (info kindInfo) gated() bool {
+	return info.context != nil || info.check != nil
+}
+
+// gate keeps up to n of the offsets of matches in text which have a keyword
+// nearby and pass the check of the kind, or all of them if n is negative
+func (info kindInfo) gate(text string, locs [][]int, n int) [][]int {
+	kept := locs[:0]
+	for _, loc := range locs {
+		if n >= 0 && len(kept) == n {
+			break
+		}
+		if (info.context == nil || info.nearContext(text, loc[0], loc[1])) &&
+			(info.check == nil || info.check(text, loc[0], loc[1])) {
+			kept = append(kept, loc)
+		}
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	return kept
+}
+
+// nearContext reports whether a keyword of the kind lies within contextWindow
+// bytes of text[start:end]. The window is widened to whole words, so that it
+// does not find a keyword at the cut end of a longer word.
+func (info kindInfo) nearContext(text string, start, end int) bool {
+	return info.context.MatchString(around(text, start, end))
+}
+
+// around returns the text within contextWindow bytes of text[start:end],
+// widened to whole words
+func around(text string, start, end int) string {
+	from, to := start-contextWindow, end+contextWindow
+	if from < 0 {
+		from = 0
+	}
+	if to > len(text) {
+		to = len(text)
+	}
+	for from > 0 && isWordByte(text[from-1]) {
+		from--
+	}
+	for to < len(text) && isWordByte(text[to]) {
+		to++
+	}
+	return text[from:to]
+}
+
+// builtinKinds lists every built-in kind in declaration order, which is also
+// the order matches at the same offset are reported in. Each kind declares
+// the byte sets every one of its matches draws at least one byte from, rarest
+// first, for the prefilter.
+var builtinKinds = []kindInfo{
+	newKind(KindDate, DateRegex, digitBytes).withCheck(wholeDate),
+	newKind(KindTime, TimeRegex, digitBytes, ":aApP"),
+	newKind(KindTimestamp, TimestampRegex, ":", digitBytes),
+	newGatedKind(KindEpochTime, EpochTimeRegex, EpochTimeContextRegex, "1"),
+	newKind(KindPhone, PhoneRegex, digitBytes),
+	newKind(KindPhoneWithExt, PhonesWithExtsRegex, digitBytes, "#xXeE"),
+	newKind(KindLink, LinkRegex, "."),
+	newKind(KindEmail, EmailRegex, "@"),
+	newKind(KindIPv4, IPv4Regex, ".", digitBytes),
+	newKind(KindIPv6, IPv6Regex, ":"),
+	newKind(KindIP, IPRegex, ".:"),
+	newKind(KindNotKnownPort, NotKnownPortRegex, digitBytes),
+	newKind(KindPrice, PriceRegex, digitBytes).withFind(findPrices).withCheck(wholeAmount),
+	newKind(KindHexColor, HexColorRegex),
+	newKind(KindCreditCard, CreditCardRegex, digitBytes),
+	newKind(KindBtcAddress, BtcAddressRegex, "13"),
+	newKind(KindEthAddress, EthAddressRegex, "x").withCheck(validMatch(ValidEthAddress)),
+	newKind(KindLtcAddress, LtcAddressRegex, "LMl").withCheck(validMatch(ValidLtcAddress)),
+	newKind(KindBchAddress, BchAddressRegex, "qp").withCheck(validMatch(ValidBchAddress)),
+	newKind(KindDogeAddress, DogeAddressRegex, "D").withCheck(validMatch(ValidDogeAddress)),
+	newKind(KindXmrAddress, XmrAddressRegex, "48").withCheck(validMatch(ValidXmrAddress)),
+	newGatedKind(KindSolAddress, SolAddressRegex, SolAddressContextRegex).withCheck(validMatch(ValidSolAddress)),
+	newKind(KindTrxAddress, TrxAddressRegex, "T").withCheck(validMatch(ValidTrxAddress)),
+	newKind(KindXrpAddress, XrpAddressRegex, "r").withCheck(validMatch(ValidXrpAddress)),
+	newKind(KindStreetAddress, StreetAddressRegex, digitBytes),
+	newKind(KindZipCode, ZipCodeRegex, digitBytes),
+	newKind(KindPoBox, PoBoxRegex, caseless("x"), caseless("b"), digitBytes),
+	newKind(KindSSN, SSNRegex, "-").withCheck(validMatch(ValidSSN)),
+	newKind(KindNINO, NINORegex, upperBytes, digitBytes).withCheck(validMatch(ValidNINO)),
+	newKind(KindSIN, SINRegex, digitBytes).withCheck(validMatch(ValidSIN)),
+	newKind(KindNIR, NIRRegex, digitBytes).withCheck(validMatch(ValidNIR)),
+	newKind(KindSteuerID, SteuerIDRegex, digitBytes).withCheck(validMatch(ValidSteuerID)),
+	newKind(KindFiscalCode, FiscalCodeRegex, "ABCDEHLMPRST").withCheck(validMatch(ValidFiscalCode)),
+	newKind(KindDNI, DNIRegex, upperBytes, digitBytes).withCheck(validMatch(ValidDNI)),
+	newKind(KindNIE, NIERegex, "XYZ").withCheck(validMatch(ValidNIE)),
+	newKind(KindBSN, BSNRegex, digitBytes).withCheck(validMatch(ValidBSN)),
+	newKind(KindAadhaar, AadhaarRegex, "23456789").withCheck(validMatch(ValidAadhaar)),
+	newKind(KindPAN, PANRegex, "ABCFGHJLPT").withCheck(validMatch(ValidPAN)),
+	newKind(KindCPF, CPFRegex, digitBytes).withCheck(validMatch(ValidCPF)),
+	newKind(KindCNPJ, CNPJRegex, digitBytes).withCheck(validMatch(ValidCNPJ)),
+	newKind(KindRRN, RRNRegex, "12345678").withCheck(validMatch(ValidRRN)),
+	newGatedKind(KindPassport, PassportRegex, PassportContextRegex, digitBytes),
+	newGatedKind(KindDriversLicense, DriversLicenseRegex, DriversLicenseContextRegex).withCheck(stateHinted),
+	newGatedKind(KindEIN, EINRegex, EINContextRegex, digitBytes),
+	newGatedKind(KindITIN, ITINRegex, ITINContextRegex, "9"),
+	newKind(KindMD5Hex, MD5HexRegex),
+	newKind(KindSHA1Hex, SHA1HexRegex),
+	newKind(KindSHA256Hex, SHA256HexRegex),
+	newKind(KindGUID, GUIDRegex),
+	newKind(KindISBN13, ISBN13Regex, digitBytes),
+	newKind(KindISBN10, ISBN10Regex, digitBytes),
+	newKind(KindVISACreditCard, VISACreditCardRegex, "4"),
+	newKind(KindMCCreditCard, MCCreditCardRegex, "5"),
+	newKind(KindMACAddress, MACAddressRegex, ":-"),
+	newKind(KindIBAN, IBANRegex, upperBytes, digitBytes),
+	newKind(KindGitRepo, GitRepoRegex, ":", "."),
+}
+
+var kindIndex = make(map[Kind]int, len(builtinKinds))
+
+func init() {
+	for i, info := range builtinKinds {
+		kindIndex[info.kind] = i
+	}
+}
+
+// Match is a match of a pattern in a text. Start and End are the byte offsets
+// of the match, so Value is always text[Start:End].
+type Match struct {
+	Kind  Kind
+	Value string
+	Start int
+	End   int
+}
+
+// Kinds returns all built-in kinds
+func Kinds() []Kind {
+	kinds := make([]Kind, len(builtinKinds))
+	for i, info := range builtinKinds {
+		kinds[i] = info.kind
+	}
+	return kinds
+}
+
+// Regex returns the compiled regular expression of a kind, or nil if the
+// kind is unknown
+func (k Kind) Regex() *regexp.Regexp {
+	if info, ok := lookupKind(k); ok {
+		return info.regex
+	}
+	return nil
+}
+
+func lookupKind(k Kind) (kindInfo, bool) {
+	i, ok := kindIndex[k]
+	if !ok {
+		return kindInfo{}, false
+	}
+	return builtinKinds[i], true
+}
+
+// selectKinds returns the infos of the given kinds, or of all built-in kinds
+// if none are given. Unknown kinds are skipped.
+func selectKinds(kinds []Kind) []kindInfo {
+	if len(kinds) == 0 {
+		return builtinKinds
+	}
+	infos := make([]kindInfo, 0, len(kinds))
+	for _, k := range kinds {
+		if info, ok := lookupKind(k); ok {
+			infos = append(infos, info)
+		}
+	}
+	return infos
+}
+
+// findMatches returns the matches of info in text[from:to], with offsets into
+// text. Context-gated and checked kinds look around the match in all of text,
+// so that a chunk finds what scanning the whole text finds.
+func findMatches(text string, from, to int, info kindInfo) []Match {
+	locs := info.filter.findAllIndex(info.regex, text[from:to], -1)
+	for _, loc := range locs {
+		loc[0] += from
+		loc[1] += from
+	}
+	if info.gated() {
+		locs = info.gate(text, locs, -1)
+	}
+	matches := make([]Match, len(locs))
+	for i, loc := range locs {
+		matches[i] = Match{Kind: info.kind, Value: text[loc[0]:loc[1]], Start: loc[0], End: loc[1]}
+	}
+	return matches
+}
+
+// sortMatches orders matches by offset. Matches spanning the same bytes keep
+// the order they were found in.
+func sortMatches(matches []Match) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Start != matches[j].Start {
+			return matches[i].Start < matches[j].Start
+		}
+		return matches[i].End < matches[j].End
+	})
+}
+
+// Scan finds the matches of the given kinds, or of every built-in kind if none
+// are given, in a single call. The matches are ordered by offset.
+func Scan(text string, kinds ...Kind) []Match {
+	var matches []Match
+	for _, info := range selectKinds(kinds) {
+		matches = append(matches, findMatches(text, 0, len(text), info)...)
+	}
+	sortMatches(matches)
+	return matches
+}
@@ -0,0 +1,42 @@
+package commonregex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScan_Scan(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := `John, please get that article on www.linkedin.com to me by 5:00PM on Jan 9th 2012. If you have any questions, You can reach me at (519)-236-2723x341 or get in touch with my associate at harold.smith@gmail.com`
+
+	matches := Scan(text, KindDate, KindTime, KindEmail)
+	assert.Equal([]Match{
+		{Kind: KindTime, Value: "5:00PM", Start: 59, End: 65},
+		{Kind: KindDate, Value: "Jan 9th 2012", Start: 69, End: 81},
+		{Kind: KindEmail, Value: "harold.smith@gmail.com", Start: 186, End: 208},
+	}, matches)
+
+	for _, match := range Scan(text) {
+		assert.Equal(text[match.Start:match.End], match.Value)
+	}
+
+	assert.Empty(Scan(text, Kind("unknown")))
+	assert.Empty(Scan("", KindEmail))
+}
+
+func TestScan_Kinds(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	kinds := Kinds()
+	assert.Len(kinds, len(builtinKinds))
+	assert.Equal(KindDate, kinds[0])
+	for _, kind := range kinds {
+		assert.NotNil(kind.Regex(), "%s should have a regex", kind)
+	}
+	assert.Equal(EmailRegex, KindEmail.Regex())
+	assert.Nil(Kind("unknown").Regex())
+}
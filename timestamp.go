@@ -0,0 +1,193 @@
+package commonregex
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Timestamp is a log timestamp or a Unix time found by FindTimestamps
+type Timestamp struct {
+	// Value is the timestamp as written, like "[23/Mar/2017:09:45:00 +0000]"
+	Value string
+	// Time is the time the timestamp stands for, in its time zone
+	Time time.Time
+	// Start and End are the offsets of the timestamp in the text searched
+	Start, End int
+}
+
+// timeZoneOffsets are the offsets from UTC, in minutes, of the time zone
+// abbreviations TimestampPattern knows. Where an abbreviation is ambiguous,
+// like IST, the most common zone is meant.
+var timeZoneOffsets = map[string]int{
+	"UTC": 0, "GMT": 0, "WET": 0,
+	"EST": -5 * 60, "EDT": -4 * 60, "CST": -6 * 60, "CDT": -5 * 60,
+	"MST": -7 * 60, "MDT": -6 * 60, "PST": -8 * 60, "PDT": -7 * 60,
+	"AKST": -9 * 60, "AKDT": -8 * 60, "HST": -10 * 60,
+	"BST": 60, "WEST": 60, "CET": 60, "CEST": 2 * 60, "EET": 2 * 60, "EEST": 3 * 60, "MSK": 3 * 60,
+	"IST": 5*60 + 30, "SGT": 8 * 60, "HKT": 8 * 60, "AWST": 8 * 60, "JST": 9 * 60, "KST": 9 * 60,
+	"ACST": 9*60 + 30, "AEST": 10 * 60, "AEDT": 11 * 60, "NZST": 12 * 60, "NZDT": 13 * 60,
+}
+
+var (
+	isoTimestampRegex    = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})[T ](\d{2}):(\d{2})(?::(\d{2})(?:[.,](\d+))?)?(.*)$`)
+	syslogTimestampRegex = regexp.MustCompile(`^(?:[A-Za-z]{3} )?([A-Za-z]{3}) +(\d{1,2}) (\d{2}):(\d{2}):(\d{2})(?:\.(\d+))?(?: ([A-Z]+))?(?: (\d{4}))?$`)
+	apacheTimestampRegex = regexp.MustCompile(`^\[(\d{2})/([A-Za-z]{3})/(\d{4}):(\d{2}):(\d{2}):(\d{2}) (.*)\]$`)
+	zoneOffsetRegex      = regexp.MustCompile(`^([+-])(\d{2}):?(\d{2})$`)
+)
+
+// timeZone returns the location of a zone written as "Z", an offset like
+// "+01:00" or "-0500", or an abbreviation like "PST", with the spaces before
+// it. No zone at all is UTC.
+func timeZone(zone string) (*time.Location, bool) {
+	zone = strings.TrimSpace(zone)
+	if zone == "" || zone == "Z" {
+		return time.UTC, true
+	}
+	if minutes, ok := timeZoneOffsets[zone]; ok {
+		return time.FixedZone(zone, minutes*60), true
+	}
+	sub := zoneOffsetRegex.FindStringSubmatch(zone)
+	if sub == nil {
+		return nil, false
+	}
+	hours, _ := strconv.Atoi(sub[2])
+	minutes, _ := strconv.Atoi(sub[3])
+	offset := (hours*60 + minutes) * 60
+	if sub[1] == "-" {
+		offset = -offset
+	}
+	return time.FixedZone("", offset), true
+}
+
+// monthAbbreviations are the months as TimestampPattern writes them
+var monthAbbreviations = []string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+
+// monthNumber returns the number of the month abbreviated as in "Mar", or 0
+func monthNumber(abbr string) int {
+	for i, name := range monthAbbreviations {
+		if strings.EqualFold(name, abbr) {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// nanoseconds returns the nanoseconds of the fraction of a second written
+// after its point
+func nanoseconds(fraction string) int {
+	if len(fraction) > 9 {
+		fraction = fraction[:9]
+	}
+	n, _ := strconv.Atoi((fraction + "000000000")[:9])
+	return n
+}
+
+// newTimestamp returns the time with the given fields, written as decimal
+// numbers, or false if the day does not exist in its month
+func newTimestamp(year, month, day, hour, minute, second, fraction string, loc *time.Location) (time.Time, bool) {
+	fields := make([]int, 6)
+	for i, field := range []string{year, month, day, hour, minute, second} {
+		fields[i], _ = strconv.Atoi(field)
+	}
+	t := time.Date(fields[0], time.Month(fields[1]), fields[2], fields[3], fields[4], fields[5], nanoseconds(fraction), loc)
+	if t.Day() != fields[2] || int(t.Month()) != fields[1] || t.Hour() != fields[3] {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// parseTimestamp reads a timestamp matched by TimestampPattern or
+// EpochTimePattern. Syslog timestamps without a year are taken to be in the
+// year of ref.
+func parseTimestamp(s string, ref time.Time) (time.Time, bool) {
+	if sub := apacheTimestampRegex.FindStringSubmatch(s); sub != nil {
+		loc, ok := timeZone(sub[7])
+		if !ok {
+			return time.Time{}, false
+		}
+		return newTimestamp(sub[3], strconv.Itoa(monthNumber(sub[2])), sub[1], sub[4], sub[5], sub[6], "", loc)
+	}
+	if sub := isoTimestampRegex.FindStringSubmatch(s); sub != nil {
+		loc, ok := timeZone(sub[8])
+		if !ok {
+			return time.Time{}, false
+		}
+		return newTimestamp(sub[1], sub[2], sub[3], sub[4], sub[5], sub[6], sub[7], loc)
+	}
+	if sub := syslogTimestampRegex.FindStringSubmatch(s); sub != nil {
+		loc, ok := timeZone(sub[7])
+		if !ok {
+			return time.Time{}, false
+		}
+		year := sub[8]
+		if year == "" {
+			year = strconv.Itoa(ref.Year())
+		}
+		return newTimestamp(year, strconv.Itoa(monthNumber(sub[1])), sub[2], sub[3], sub[4], sub[5], sub[6], loc)
+	}
+
+	whole, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+	}
+	if whole == "" || !isDigits(whole) || fraction != "" && !isDigits(fraction) {
+		return time.Time{}, false
+	}
+	n, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if len(whole) > 10 {
+		// milliseconds
+		return time.Unix(n/1000, n%1000*int64(time.Millisecond)).UTC(), true
+	}
+	return time.Unix(n, int64(nanoseconds(fraction))).UTC(), true
+}
+
+// ParseTimestamp reads a timestamp written as TimestampPattern or
+// EpochTimePattern matches it, like "2017-03-23T09:45:00Z", "Mar 23
+// 09:45:00", "[23/Mar/2017:09:45:00 +0000]" or "1490262300". Timestamps
+// without a time zone are in UTC, and syslog timestamps without a year are
+// in the current year. Unix times of 11 digits or more are milliseconds. It
+// reports false if s is not entirely such a timestamp or names a day that
+// does not exist.
+func ParseTimestamp(s string) (time.Time, bool) {
+	for _, re := range []*regexp.Regexp{TimestampRegex, EpochTimeRegex} {
+		if loc := re.FindStringIndex(s); loc != nil && loc[0] == 0 && loc[1] == len(s) {
+			return parseTimestamp(s, time.Now())
+		}
+	}
+	return time.Time{}, false
+}
+
+// FindTimestamps finds the log timestamps in text, like Timestamps, and the
+// Unix times near a keyword, like EpochTimes, in the order they appear, and
+// reads them as ParseTimestamp does. Syslog timestamps without a year are
+// taken to be in the year of the reference given with RelativeTo, or of the
+// time of the search. The Limit, Unique, CaseFold, SortByFrequency,
+// Normalized, Deobfuscated and RelativeTo options apply.
+func FindTimestamps(text string, opts ...Option) []Timestamp {
+	o := newOptions(opts)
+	ref := o.reference
+	if ref.IsZero() {
+		ref = time.Now()
+	}
+
+	var found []Timestamp
+	var values []string
+	for _, match := range o.prepare(text).scan([]Kind{KindTimestamp, KindEpochTime}) {
+		t, ok := parseTimestamp(match.Value, ref)
+		if !ok {
+			continue
+		}
+		found = append(found, Timestamp{Value: match.Value, Time: t, Start: match.Start, End: match.End})
+		values = append(values, match.Value)
+		if o.limit > 0 && !o.unique && len(found) == o.limit {
+			break
+		}
+	}
+
+	return keepFound(o, found, values)
+}
@@ -0,0 +1,96 @@
+package commonregex
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimestamp_ParseTimestamp(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	year := time.Now().Year()
+	tests := []struct {
+		value string
+		time  time.Time
+	}{
+		{"2017-03-23T09:45:00Z", time.Date(2017, 3, 23, 9, 45, 0, 0, time.UTC)},
+		{"2017-03-23 09:45", time.Date(2017, 3, 23, 9, 45, 0, 0, time.UTC)},
+		{"2017-03-23T09:45:00.5+01:00", time.Date(2017, 3, 23, 8, 45, 0, 5e8, time.UTC)},
+		{"2017-03-23T09:45:00,25 -0530", time.Date(2017, 3, 23, 15, 15, 0, 25e7, time.UTC)},
+		{"2017-03-23 09:45:00 PST", time.Date(2017, 3, 23, 17, 45, 0, 0, time.UTC)},
+		{"2017-03-23 09:45:00 IST", time.Date(2017, 3, 23, 4, 15, 0, 0, time.UTC)},
+		{"Mar 23 09:45:00", time.Date(year, 3, 23, 9, 45, 0, 0, time.UTC)},
+		{"Mar  3 09:45:00.123", time.Date(year, 3, 3, 9, 45, 0, 123e6, time.UTC)},
+		{"Thu Mar 23 09:45:00 CET 2017", time.Date(2017, 3, 23, 8, 45, 0, 0, time.UTC)},
+		{"[23/Mar/2017:09:45:00 +0000]", time.Date(2017, 3, 23, 9, 45, 0, 0, time.UTC)},
+		{"[23/Mar/2017:09:45:00 -0700]", time.Date(2017, 3, 23, 16, 45, 0, 0, time.UTC)},
+		{"1490262300", time.Date(2017, 3, 23, 9, 45, 0, 0, time.UTC)},
+		{"1490262300.25", time.Date(2017, 3, 23, 9, 45, 0, 25e7, time.UTC)},
+		{"1490262300123", time.Date(2017, 3, 23, 9, 45, 0, 123e6, time.UTC)},
+	}
+
+	for _, test := range tests {
+		parsed, ok := ParseTimestamp(test.value)
+		if assert.True(ok, "%s should be parsed", test.value) {
+			assert.True(test.time.Equal(parsed), "%s should be %v, not %v", test.value, test.time, parsed)
+		}
+	}
+
+	zoned, _ := ParseTimestamp("2017-03-23 09:45:00 PST")
+	name, offset := zoned.Zone()
+	assert.Equal("PST", name)
+	assert.Equal(-8*60*60, offset)
+
+	failingTests := []string{
+		"",
+		"2017-02-30T10:00:00Z",
+		"Feb 29 10:00:00 2017",
+		"[31/Apr/2017:09:45:00 +0000]",
+		"2017-03-23",
+		" 2017-03-23T09:45:00Z",
+		"2490262300",
+		"9:45",
+	}
+
+	for _, test := range failingTests {
+		_, ok := ParseTimestamp(test)
+		assert.False(ok, "%q should not be parsed", test)
+	}
+}
+
+func TestTimestamp_FindTimestamps(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	text := `Mar 23 09:45:00 web sshd[42]: accepted
+127.0.0.1 - - [23/Mar/2017:09:45:00 +0000] "GET / HTTP/1.1" 200
+{"level":"info","ts":1490262300.5,"msg":"started at 2017-03-23T09:45:00Z"}
+2017-02-30T10:00:00Z is not a day`
+
+	ref := time.Date(2016, 10, 21, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		time  time.Time
+	}{
+		{"Mar 23 09:45:00", time.Date(2016, 3, 23, 9, 45, 0, 0, time.UTC)},
+		{"[23/Mar/2017:09:45:00 +0000]", time.Date(2017, 3, 23, 9, 45, 0, 0, time.UTC)},
+		{"1490262300.5", time.Date(2017, 3, 23, 9, 45, 0, 5e8, time.UTC)},
+		{"2017-03-23T09:45:00Z", time.Date(2017, 3, 23, 9, 45, 0, 0, time.UTC)},
+	}
+
+	found := FindTimestamps(text, RelativeTo(ref))
+	if assert.Len(found, len(tests)) {
+		for i, test := range tests {
+			assert.Equal(test.value, found[i].Value)
+			assert.Equal(test.value, text[found[i].Start:found[i].End])
+			assert.True(test.time.Equal(found[i].Time), "%s should be %v, not %v", test.value, test.time, found[i].Time)
+		}
+	}
+
+	assert.Len(FindTimestamps(text, Limit(2)), 2)
+	assert.Len(FindTimestamps(text+"\n"+text, Unique()), len(tests))
+	assert.Empty(FindTimestamps("call 1490262300 now"), "a Unix time needs a keyword nearby")
+}
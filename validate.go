@@ -0,0 +1,843 @@
+package commonregex
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mingrammer/commonregex/internal/keccak"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// stripSeparators removes the spaces and dashes numbers are commonly grouped with
+func stripSeparators(s string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(s)
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// luhnValid reports whether a string of digits passes the Luhn check
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// ValidCreditCard reports whether a credit card number passes the Luhn check.
+// Spaces and dashes between digit groups are ignored.
+func ValidCreditCard(number string) bool {
+	digits := stripSeparators(number)
+	return len(digits) >= 12 && len(digits) <= 19 && isDigits(digits) && luhnValid(digits)
+}
+
+// ValidIBAN reports whether an IBAN has valid ISO 7064 mod 97-10 check digits
+func ValidIBAN(iban string) bool {
+	iban = strings.ToUpper(strings.Replace(iban, " ", "", -1))
+	if len(iban) < 5 || len(iban) > 34 {
+		return false
+	}
+	remainder := 0
+	for _, c := range iban[4:] + iban[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+	return remainder == 1
+}
+
+// ValidISBN13 reports whether an ISBN-13 has a valid check digit. Dashes and
+// spaces are ignored.
+func ValidISBN13(isbn string) bool {
+	digits := stripSeparators(isbn)
+	if len(digits) != 13 || !isDigits(digits) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 13; i++ {
+		d := int(digits[i] - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// ValidISBN10 reports whether an ISBN-10 has a valid check digit, which may be
+// an 'X' standing for 10. Dashes and spaces are ignored.
+func ValidISBN10(isbn string) bool {
+	digits := stripSeparators(isbn)
+	if len(digits) != 10 || !isDigits(digits[:9]) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += (10 - i) * int(digits[i]-'0')
+	}
+	switch check := digits[9]; {
+	case check == 'x' || check == 'X':
+		sum += 10
+	case check >= '0' && check <= '9':
+		sum += int(check - '0')
+	default:
+		return false
+	}
+	return sum%11 == 0
+}
+
+// base58Decode decodes a base58 string written in the given alphabet
+func base58Decode(s, alphabet string) ([]byte, bool) {
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	for i := 0; i < len(s); i++ {
+		v := strings.IndexByte(alphabet, s[i])
+		if v < 0 {
+			return nil, false
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(v)))
+	}
+	decoded := n.Bytes()
+	leadingZeros := 0
+	for leadingZeros < len(s) && s[leadingZeros] == alphabet[0] {
+		leadingZeros++
+	}
+	return append(make([]byte, leadingZeros), decoded...), true
+}
+
+// base58CheckDecode decodes a base58check string and verifies its checksum,
+// returning the version byte followed by the payload
+func base58CheckDecode(s, alphabet string) ([]byte, bool) {
+	decoded, ok := base58Decode(s, alphabet)
+	if !ok || len(decoded) < 5 {
+		return nil, false
+	}
+	data, checksum := decoded[:len(decoded)-4], decoded[len(decoded)-4:]
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	if string(second[:4]) != string(checksum) {
+		return nil, false
+	}
+	return data, true
+}
+
+// ValidBtcAddress reports whether a legacy bitcoin address has a valid
+// base58check checksum and a P2PKH or P2SH version byte
+func ValidBtcAddress(address string) bool {
+	data, ok := base58CheckDecode(address, base58Alphabet)
+	return ok && len(data) == 21 && (data[0] == 0x00 || data[0] == 0x05)
+}
+
+// rippleAlphabet is the base58 alphabet of XRP Ledger addresses
+const rippleAlphabet = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+
+// ValidEthAddress reports whether an Ethereum address is 0x followed by 40
+// hex digits with a valid EIP-55 checksum. Addresses in all lowercase or all
+// uppercase carry no checksum and are accepted.
+func ValidEthAddress(address string) bool {
+	if len(address) != 42 || address[0] != '0' || (address[1] != 'x' && address[1] != 'X') {
+		return false
+	}
+	hexDigits := address[2:]
+	if _, err := hex.DecodeString(hexDigits); err != nil {
+		return false
+	}
+	lower := strings.ToLower(hexDigits)
+	if hexDigits == lower || hexDigits == strings.ToUpper(hexDigits) {
+		return true
+	}
+	hash := keccak.Sum256([]byte(lower))
+	for i := 0; i < len(hexDigits); i++ {
+		c := hexDigits[i]
+		if c < 'A' {
+			continue
+		}
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+		if (nibble >= 8) != (c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidLtcAddress reports whether a Litecoin address is a base58check P2PKH
+// ("L...") or P2SH ("M...") address, or a bech32 segwit address ("ltc1...")
+// with a valid checksum. Legacy P2SH addresses starting with "3" are not
+// accepted, as they cannot be told apart from bitcoin ones.
+func ValidLtcAddress(address string) bool {
+	if strings.HasPrefix(strings.ToLower(address), "ltc1") {
+		return validSegwitAddress(address, "ltc")
+	}
+	data, ok := base58CheckDecode(address, base58Alphabet)
+	return ok && len(data) == 21 && (data[0] == 0x30 || data[0] == 0x32)
+}
+
+// ValidBchAddress reports whether a Bitcoin Cash address is a CashAddr
+// P2PKH ("q...") or P2SH ("p...") address with a valid checksum. The
+// "bitcoincash:" prefix is optional.
+func ValidBchAddress(address string) bool {
+	const prefix = "bitcoincash"
+	payload := address
+	if i := strings.IndexByte(address, ':'); i >= 0 {
+		if !strings.EqualFold(address[:i], prefix) {
+			return false
+		}
+		payload = address[i+1:]
+	}
+	if payload != strings.ToLower(payload) && payload != strings.ToUpper(payload) {
+		return false
+	}
+	values, ok := base32Values(strings.ToLower(payload))
+	if !ok || len(values) != 42 || cashAddrPolymod(prefix, values) != 0 {
+		return false
+	}
+	data, ok := convertBits(values[:len(values)-8], 5, 8, false)
+	return ok && len(data) == 21 && (data[0] == 0x00 || data[0] == 0x08)
+}
+
+// ValidDogeAddress reports whether a Dogecoin address has a valid base58check
+// checksum and the P2PKH ("D...") version byte
+func ValidDogeAddress(address string) bool {
+	data, ok := base58CheckDecode(address, base58Alphabet)
+	return ok && len(data) == 21 && data[0] == 0x1e
+}
+
+// ValidXmrAddress reports whether a Monero mainnet standard ("4..."),
+// subaddress ("8...") or integrated address has a valid checksum
+func ValidXmrAddress(address string) bool {
+	data, ok := moneroBase58Decode(address)
+	if !ok || len(data) < 5 {
+		return false
+	}
+	payload, checksum := data[:len(data)-4], data[len(data)-4:]
+	hash := keccak.Sum256(payload)
+	if string(hash[:4]) != string(checksum) {
+		return false
+	}
+	switch payload[0] {
+	case 0x12, 0x2a:
+		return len(payload) == 65
+	case 0x13:
+		return len(payload) == 73
+	}
+	return false
+}
+
+// ValidSolAddress reports whether a Solana address is the base58 encoding of
+// a 32-byte public key. Solana addresses have no checksum, so this only
+// rules out strings of the wrong length.
+func ValidSolAddress(address string) bool {
+	data, ok := base58Decode(address, base58Alphabet)
+	return ok && len(data) == 32
+}
+
+// ValidTrxAddress reports whether a Tron address has a valid base58check
+// checksum and the 0x41 version byte
+func ValidTrxAddress(address string) bool {
+	data, ok := base58CheckDecode(address, base58Alphabet)
+	return ok && len(data) == 21 && data[0] == 0x41
+}
+
+// ValidXrpAddress reports whether an XRP Ledger classic address has a valid
+// base58check checksum in the ripple alphabet
+func ValidXrpAddress(address string) bool {
+	data, ok := base58CheckDecode(address, rippleAlphabet)
+	return ok && len(data) == 21 && data[0] == 0x00
+}
+
+// bech32Charset maps 5-bit values to the characters of bech32 and CashAddr
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// Checksum constants of bech32 (BIP 173) and bech32m (BIP 350)
+const (
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+)
+
+// base32Values maps the characters of a lowercase bech32 string to their
+// 5-bit values
+func base32Values(s string) ([]byte, bool) {
+	values := make([]byte, len(s))
+	for i := 0; i < len(s); i++ {
+		v := strings.IndexByte(bech32Charset, s[i])
+		if v < 0 {
+			return nil, false
+		}
+		values[i] = byte(v)
+	}
+	return values, true
+}
+
+// convertBits regroups a sequence of from-bit values into to-bit values
+func convertBits(data []byte, from, to uint, pad bool) ([]byte, bool) {
+	acc, bits := 0, uint(0)
+	maxValue := 1<<to - 1
+	var out []byte
+	for _, v := range data {
+		acc = acc<<from | int(v)
+		bits += from
+		for bits >= to {
+			bits -= to
+			out = append(out, byte(acc>>bits&maxValue))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			out = append(out, byte(acc<<(to-bits)&maxValue))
+		}
+	} else if bits >= from || acc<<(to-bits)&maxValue != 0 {
+		return nil, false
+	}
+	return out, true
+}
+
+func bech32Polymod(hrp string, values []byte) int {
+	generator := [5]int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := 1
+	step := func(v byte) {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ int(v)
+		for i, g := range generator {
+			if top>>uint(i)&1 == 1 {
+				chk ^= g
+			}
+		}
+	}
+	for i := 0; i < len(hrp); i++ {
+		step(hrp[i] >> 5)
+	}
+	step(0)
+	for i := 0; i < len(hrp); i++ {
+		step(hrp[i] & 31)
+	}
+	for _, v := range values {
+		step(v)
+	}
+	return chk
+}
+
+// validSegwitAddress reports whether address is a segwit address with the
+// given human-readable part: bech32 for witness version 0 and bech32m for
+// later versions, with a program of a valid length
+func validSegwitAddress(address, hrp string) bool {
+	if address != strings.ToLower(address) && address != strings.ToUpper(address) {
+		return false
+	}
+	address = strings.ToLower(address)
+	if len(address) > 90 || !strings.HasPrefix(address, hrp+"1") {
+		return false
+	}
+	values, ok := base32Values(address[len(hrp)+1:])
+	if !ok || len(values) < 7 {
+		return false
+	}
+	version := values[0]
+	switch polymod := bech32Polymod(hrp, values); {
+	case version == 0 && polymod != bech32Const:
+		return false
+	case version > 0 && polymod != bech32mConst:
+		return false
+	case version > 16:
+		return false
+	}
+	program, ok := convertBits(values[1:len(values)-6], 5, 8, false)
+	if !ok || len(program) < 2 || len(program) > 40 {
+		return false
+	}
+	return version != 0 || len(program) == 20 || len(program) == 32
+}
+
+// cashAddrPolymod returns the CashAddr checksum of values under prefix,
+// which is zero if values end in a valid checksum
+func cashAddrPolymod(prefix string, values []byte) uint64 {
+	generator := [5]uint64{0x98f2bc8e61, 0x79b76d99e2, 0xf33e5fb3c4, 0xae2eabe2a8, 0x1e4f43e470}
+	c := uint64(1)
+	step := func(v byte) {
+		top := c >> 35
+		c = (c&0x07ffffffff)<<5 ^ uint64(v)
+		for i, g := range generator {
+			if top>>uint(i)&1 == 1 {
+				c ^= g
+			}
+		}
+	}
+	for i := 0; i < len(prefix); i++ {
+		step(prefix[i] & 31)
+	}
+	step(0)
+	for _, v := range values {
+		step(v)
+	}
+	return c ^ 1
+}
+
+// moneroBlockSizes maps the length of an encoded block of Monero's base58 to
+// the number of bytes it decodes to, or -1 for impossible lengths
+var moneroBlockSizes = [12]int{0, -1, 1, 2, -1, 3, 4, 5, -1, 6, 7, 8}
+
+// moneroBase58Decode decodes Monero's base58, which encodes every 8 bytes
+// as a block of 11 characters
+func moneroBase58Decode(s string) ([]byte, bool) {
+	var out []byte
+	for len(s) > 0 {
+		n := 11
+		if len(s) < n {
+			n = len(s)
+		}
+		size := moneroBlockSizes[n]
+		if size < 0 {
+			return nil, false
+		}
+		var value uint64
+		for i := 0; i < n; i++ {
+			v := strings.IndexByte(base58Alphabet, s[i])
+			if v < 0 {
+				return nil, false
+			}
+			hi, lo := bits.Mul64(value, 58)
+			lo, carry := bits.Add64(lo, uint64(v), 0)
+			if hi != 0 || carry != 0 {
+				return nil, false
+			}
+			value = lo
+		}
+		if size < 8 && value>>(8*uint(size)) != 0 {
+			return nil, false
+		}
+		var block [8]byte
+		binary.BigEndian.PutUint64(block[:], value)
+		out = append(out, block[8-size:]...)
+		s = s[n:]
+	}
+	return out, true
+}
+
+// advertisedSSNs are numbers that appeared in advertisements and were voided
+var advertisedSSNs = map[string]bool{
+	"078051120": true,
+	"219099999": true,
+}
+
+// ValidSSN reports whether a US social security number is one the SSA may
+// have assigned: its area is not 000, 666 or 900-999, its group not 00, its
+// serial not 0000, and it is not a number voided after appearing in
+// advertisements. Dashes and spaces are ignored.
+func ValidSSN(ssn string) bool {
+	digits := stripSeparators(ssn)
+	if len(digits) != 9 || !isDigits(digits) {
+		return false
+	}
+	area, group, serial := digits[:3], digits[3:5], digits[5:]
+	return area != "000" && area != "666" && area[0] != '9' &&
+		group != "00" && serial != "0000" && !advertisedSSNs[digits]
+}
+
+// ValidNINO reports whether a UK National Insurance number has a prefix that
+// is allocated, six digits and a suffix from A to D. NINOs have no check
+// digit. Spaces are ignored.
+func ValidNINO(nino string) bool {
+	nino = strings.ToUpper(strings.Replace(nino, " ", "", -1))
+	if len(nino) != 9 || !isDigits(nino[2:8]) || nino[8] < 'A' || nino[8] > 'D' {
+		return false
+	}
+	first, second := nino[0], nino[1]
+	if first < 'A' || first > 'Z' || strings.IndexByte("DFIQUV", first) >= 0 ||
+		second < 'A' || second > 'Z' || strings.IndexByte("DFIOQUV", second) >= 0 {
+		return false
+	}
+	switch nino[:2] {
+	case "BG", "GB", "KN", "NK", "NT", "TN", "ZZ":
+		return false
+	}
+	return true
+}
+
+// ValidSIN reports whether a Canadian Social Insurance Number passes the Luhn
+// check. Numbers starting with 0 or 8 are not assigned. Spaces and dashes
+// are ignored.
+func ValidSIN(sin string) bool {
+	digits := stripSeparators(sin)
+	return len(digits) == 9 && isDigits(digits) && digits[0] != '0' && digits[0] != '8' && luhnValid(digits)
+}
+
+// ValidNIR reports whether a French social security number (INSEE or NIR)
+// has a valid two-digit key, 97 minus the first 13 digits modulo 97. The
+// Corsican departments 2A and 2B count as 19 and 18. Spaces are ignored.
+func ValidNIR(nir string) bool {
+	nir = strings.ToUpper(strings.Replace(nir, " ", "", -1))
+	if len(nir) != 15 || strings.IndexByte("123478", nir[0]) < 0 {
+		return false
+	}
+	body := nir[:13]
+	switch nir[5:7] {
+	case "2A":
+		body = body[:5] + "19" + body[7:]
+	case "2B":
+		body = body[:5] + "18" + body[7:]
+	}
+	if !isDigits(body) || !isDigits(nir[13:]) {
+		return false
+	}
+	n, _ := strconv.ParseInt(body, 10, 64)
+	key, _ := strconv.Atoi(nir[13:])
+	return key == int(97-n%97)
+}
+
+// ValidSteuerID reports whether a German tax identification number has a
+// valid ISO 7064 MOD 11,10 check digit and exactly one digit repeated two or
+// three times among its first ten. Spaces are ignored.
+func ValidSteuerID(id string) bool {
+	digits := strings.Replace(id, " ", "", -1)
+	if len(digits) != 11 || !isDigits(digits) || digits[0] == '0' {
+		return false
+	}
+	var counts [10]int
+	for i := 0; i < 10; i++ {
+		counts[digits[i]-'0']++
+	}
+	repeated := 0
+	for d, c := range counts {
+		switch {
+		case c == 2:
+			repeated++
+		case c == 3:
+			repeated++
+			if strings.Contains(digits[:10], strings.Repeat(string(rune('0'+d)), 3)) {
+				return false
+			}
+		case c > 3:
+			return false
+		}
+	}
+	if repeated != 1 {
+		return false
+	}
+
+	product := 10
+	for i := 0; i < 10; i++ {
+		sum := (int(digits[i]-'0') + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = sum * 2 % 11
+	}
+	check := 11 - product
+	if check == 10 {
+		check = 0
+	}
+	return check == int(digits[10]-'0')
+}
+
+// fiscalCodeOdd holds the values of the characters in the odd positions of an
+// Italian fiscal code, indexed by digit or letter
+var fiscalCodeOdd = [26]int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23}
+
+// fiscalCodeDigits are the letters that replace digits in the fiscal codes of
+// people who would otherwise share one (omocodia)
+const fiscalCodeDigits = "LMNPQRSTUV"
+
+// ValidFiscalCode reports whether an Italian fiscal code (codice fiscale) has
+// a valid month letter, day of birth and check letter
+func ValidFiscalCode(code string) bool {
+	code = strings.ToUpper(code)
+	if len(code) != 16 {
+		return false
+	}
+	normalized := []byte(code)
+	for i := 0; i < 15; i++ {
+		c := code[i]
+		switch i {
+		case 6, 7, 9, 10, 12, 13, 14:
+			if j := strings.IndexByte(fiscalCodeDigits, c); j >= 0 {
+				normalized[i] = byte('0' + j)
+			} else if c < '0' || c > '9' {
+				return false
+			}
+		default:
+			if c < 'A' || c > 'Z' {
+				return false
+			}
+		}
+	}
+	if strings.IndexByte("ABCDEHLMPRST", code[8]) < 0 {
+		return false
+	}
+	day, _ := strconv.Atoi(string(normalized[9:11]))
+	if day < 1 || day > 71 || day > 31 && day < 41 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 15; i++ {
+		v := int(normalized[i] - 'A')
+		if normalized[i] <= '9' {
+			v = int(normalized[i] - '0')
+		}
+		if i%2 == 0 {
+			sum += fiscalCodeOdd[v]
+		} else {
+			sum += v
+		}
+	}
+	return code[15] == byte('A'+sum%26)
+}
+
+// dniLetters maps the remainder of a Spanish identity number modulo 23 to its
+// check letter
+const dniLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+
+// ValidDNI reports whether a Spanish national identity number (DNI) has the
+// check letter of its eight digits. Dashes are ignored.
+func ValidDNI(dni string) bool {
+	dni = strings.ToUpper(strings.Replace(dni, "-", "", -1))
+	if len(dni) != 9 || !isDigits(dni[:8]) {
+		return false
+	}
+	n, _ := strconv.Atoi(dni[:8])
+	return dni[8] == dniLetters[n%23]
+}
+
+// ValidNIE reports whether a Spanish foreigner identity number (NIE) has a
+// valid check letter. The leading X, Y or Z counts as 0, 1 or 2. Dashes are
+// ignored.
+func ValidNIE(nie string) bool {
+	nie = strings.ToUpper(strings.Replace(nie, "-", "", -1))
+	if len(nie) != 9 {
+		return false
+	}
+	prefix := strings.IndexByte("XYZ", nie[0])
+	if prefix < 0 {
+		return false
+	}
+	return ValidDNI(strconv.Itoa(prefix) + nie[1:])
+}
+
+// ValidBSN reports whether a Dutch citizen service number (BSN) passes the
+// eleven test: its digits weighted 9 down to 2, and the last by -1, sum to a
+// multiple of 11. Dots are ignored.
+func ValidBSN(bsn string) bool {
+	digits := strings.Replace(bsn, ".", "", -1)
+	if len(digits) != 9 || !isDigits(digits) || digits == "000000000" {
+		return false
+	}
+	sum := -int(digits[8] - '0')
+	for i := 0; i < 8; i++ {
+		sum += (9 - i) * int(digits[i]-'0')
+	}
+	return sum%11 == 0
+}
+
+// Verhoeff tables: the multiplication table of the dihedral group D5 and the
+// permutation applied at each position
+var (
+	verhoeffMultiplication = [10][10]byte{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+		{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+		{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+		{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+		{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+		{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+		{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+		{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+		{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+	}
+	verhoeffPermutation = [8][10]byte{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+		{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+		{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+		{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+		{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+		{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+		{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+	}
+)
+
+// verhoeffValid reports whether a string of digits ends in a valid Verhoeff
+// check digit
+func verhoeffValid(digits string) bool {
+	c := byte(0)
+	for i := 0; i < len(digits); i++ {
+		d := digits[len(digits)-1-i] - '0'
+		c = verhoeffMultiplication[c][verhoeffPermutation[i%8][d]]
+	}
+	return c == 0
+}
+
+// ValidAadhaar reports whether an Indian Aadhaar number has a valid Verhoeff
+// check digit. Aadhaar numbers do not start with 0 or 1. Spaces and dashes
+// are ignored.
+func ValidAadhaar(aadhaar string) bool {
+	digits := stripSeparators(aadhaar)
+	return len(digits) == 12 && isDigits(digits) && digits[0] >= '2' && verhoeffValid(digits)
+}
+
+// ValidPAN reports whether an Indian Permanent Account Number is five
+// letters, the fourth a known holder type, four digits and a letter. The
+// check letter's algorithm is not public, so it is not verified.
+func ValidPAN(pan string) bool {
+	if len(pan) != 10 || !isDigits(pan[5:9]) {
+		return false
+	}
+	for _, i := range []int{0, 1, 2, 3, 4, 9} {
+		if pan[i] < 'A' || pan[i] > 'Z' {
+			return false
+		}
+	}
+	return strings.IndexByte("ABCFGHJLPT", pan[3]) >= 0
+}
+
+// mod11CheckDigit returns the check digit Brazilian documents use: the
+// digits weighted from the right by 2, 3, ... up to maxWeight and then 2
+// again, with remainders below 2 giving 0
+func mod11CheckDigit(digits string, maxWeight int) byte {
+	sum, weight := 0, 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += weight * int(digits[i]-'0')
+		weight++
+		if weight > maxWeight {
+			weight = 2
+		}
+	}
+	if r := sum % 11; r >= 2 {
+		return byte('0' + 11 - r)
+	}
+	return '0'
+}
+
+// brazilianDigits strips the dots, slashes and dashes CPF and CNPJ numbers
+// are formatted with, and reports whether the rest is n digits not all alike
+func brazilianDigits(s string, n int) (string, bool) {
+	digits := strings.NewReplacer(".", "", "/", "", "-", "").Replace(s)
+	if len(digits) != n || !isDigits(digits) || digits == strings.Repeat(digits[:1], n) {
+		return "", false
+	}
+	return digits, true
+}
+
+// ValidCPF reports whether a Brazilian individual taxpayer number (CPF) has
+// valid check digits. Numbers of one repeated digit are rejected.
+func ValidCPF(cpf string) bool {
+	digits, ok := brazilianDigits(cpf, 11)
+	return ok && digits[9] == mod11CheckDigit(digits[:9], 11) && digits[10] == mod11CheckDigit(digits[:10], 11)
+}
+
+// ValidCNPJ reports whether a Brazilian company registration number (CNPJ)
+// has valid check digits. Numbers of one repeated digit are rejected.
+func ValidCNPJ(cnpj string) bool {
+	digits, ok := brazilianDigits(cnpj, 14)
+	return ok && digits[12] == mod11CheckDigit(digits[:12], 9) && digits[13] == mod11CheckDigit(digits[:13], 9)
+}
+
+// rrnWeights are the weights of the first twelve digits of a Korean resident
+// registration number
+var rrnWeights = [12]int{2, 3, 4, 5, 6, 7, 8, 9, 2, 3, 4, 5}
+
+// rrnChecksumEnd is the first birth date of numbers issued without a check
+// digit, as random serials replaced the old scheme in October 2020
+var rrnChecksumEnd = time.Date(2020, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+// ValidRRN reports whether a Korean resident registration number starts with
+// a real date of birth and, for people born before October 2020, has a valid
+// check digit. The seventh digit gives the century: 1, 2, 5 and 6 for the
+// 1900s and 3, 4, 7 and 8 for the 2000s. Dashes are ignored.
+func ValidRRN(rrn string) bool {
+	digits := strings.Replace(rrn, "-", "", -1)
+	if len(digits) != 13 || !isDigits(digits) {
+		return false
+	}
+	century := 1900
+	switch digits[6] {
+	case '1', '2', '5', '6':
+	case '3', '4', '7', '8':
+		century = 2000
+	default:
+		return false
+	}
+	year, _ := strconv.Atoi(digits[:2])
+	month, _ := strconv.Atoi(digits[2:4])
+	day, _ := strconv.Atoi(digits[4:6])
+	born := time.Date(century+year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if born.Month() != time.Month(month) || born.Day() != day {
+		return false
+	}
+	if !born.Before(rrnChecksumEnd) {
+		return true
+	}
+	sum := 0
+	for i, w := range rrnWeights {
+		sum += w * int(digits[i]-'0')
+	}
+	return int(digits[12]-'0') == (11-sum%11)%10
+}
+
+// einPrefixes are the first two digits the IRS assigns EINs under
+var einPrefixes = map[string]bool{
+	"01": true, "02": true, "03": true, "04": true, "05": true, "06": true,
+	"10": true, "11": true, "12": true, "13": true, "14": true, "15": true, "16": true,
+	"20": true, "21": true, "22": true, "23": true, "24": true, "25": true, "26": true, "27": true,
+	"30": true, "31": true, "32": true, "33": true, "34": true, "35": true, "36": true, "37": true, "38": true, "39": true,
+	"40": true, "41": true, "42": true, "43": true, "44": true, "45": true, "46": true, "47": true, "48": true,
+	"50": true, "51": true, "52": true, "53": true, "54": true, "55": true, "56": true, "57": true, "58": true, "59": true,
+	"60": true, "61": true, "62": true, "63": true, "64": true, "65": true, "66": true, "67": true, "68": true,
+	"71": true, "72": true, "73": true, "74": true, "75": true, "76": true, "77": true,
+	"80": true, "81": true, "82": true, "83": true, "84": true, "85": true, "86": true, "87": true, "88": true,
+	"90": true, "91": true, "92": true, "93": true, "94": true, "95": true, "98": true, "99": true,
+}
+
+// ValidEIN reports whether a US employer identification number has nine
+// digits and a prefix the IRS assigns. EINs have no check digit. Dashes are
+// ignored.
+func ValidEIN(ein string) bool {
+	digits := strings.Replace(ein, "-", "", -1)
+	return len(digits) == 9 && isDigits(digits) && einPrefixes[digits[:2]]
+}
+
+// ValidITIN reports whether a US individual taxpayer identification number
+// starts with 9 and has a middle group from 50 to 65, 70 to 88, 90 to 92 or
+// 94 to 99. Dashes and spaces are ignored.
+func ValidITIN(itin string) bool {
+	digits := stripSeparators(itin)
+	if len(digits) != 9 || !isDigits(digits) || digits[0] != '9' {
+		return false
+	}
+	group, _ := strconv.Atoi(digits[3:5])
+	return group >= 50 && group <= 65 || group >= 70 && group <= 88 ||
+		group >= 90 && group <= 92 || group >= 94
+}
@@ -0,0 +1,766 @@
+package commonregex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate_CreditCard(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"4111 1111 1111 1111",
+		"5500-0000-0000-0004",
+		"4222222222222",
+		"378282246310005",
+	}
+
+	failingTests := []string{
+		"4111 1111 1111 1112",
+		"0000 0000 0000 000a",
+		"1234",
+		"",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidCreditCard(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidCreditCard(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_IBAN(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"FR1420041010050500013M02606",
+		"MU17BOMM0101101030300200000MUR",
+		"NO9386011117947",
+		"GB29 NWBK 6016 1331 9268 19",
+	}
+
+	failingTests := []string{
+		"FR1420041010050500013M02607",
+		"GB29NWBK6016133192681",
+		"DE00",
+		"DE89-3704-0044-0532-0130-00",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidIBAN(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidIBAN(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_ISBN13(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"978-3-16-148410-0",
+		"978-1-56619-909-4",
+		"9780306406157",
+	}
+
+	failingTests := []string{
+		"133-1-12144-909-9",
+		"978-3-16-148410-1",
+		"1-56619-909-3",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidISBN13(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidISBN13(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_ISBN10(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"1-56619-909-3",
+		"0-8044-2957-X",
+		"0306406152",
+	}
+
+	failingTests := []string{
+		"1-33342-100-2",
+		"0-8044-2957-1",
+		"978-3-16-148410-0",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidISBN10(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidISBN10(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_BtcAddress(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+		"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy",
+	}
+
+	failingTests := []string{
+		"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb",
+		"1LgqButDNV2rVHe9DATt6WqD8tKZEKvaK3",
+		"0A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidBtcAddress(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidBtcAddress(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_EthAddress(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+		"0x52908400098527886e0f7030069857d2e4169ee7",
+		"0x52908400098527886E0F7030069857D2E4169EE7",
+	}
+
+	failingTests := []string{
+		"0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xD1220a0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA",
+		"0xzaAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidEthAddress(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidEthAddress(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_LtcAddress(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"Lc3veyPCuFHmqvF7VAohVp6VT4zibe1Uhz",
+		"MCgEvMpSTeVTxbwkY6ezHvGY3Tu3modt59",
+		"ltc1qnf92vle8jkamvuvdwpnnrrt4ndykdan8v7yq4y",
+		"LTC1QNF92VLE8JKAMVUVDWPNNRRT4NDYKDAN8V7YQ4Y",
+	}
+
+	failingTests := []string{
+		"Lc3veyPCuFHmqvF7VAohVp6VT4zibe1Uhy",
+		"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+		"31nM1WuowNDzocNxPPW9NQWJEtwWpjfcLj",
+		"ltc1qnf92vle8jkamvuvdwpnnrrt4ndykdan8v7yq4z",
+		"ltc1Qnf92vle8jkamvuvdwpnnrrt4ndykdan8v7yq4y",
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidLtcAddress(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidLtcAddress(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_BchAddress(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		"qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		"BITCOINCASH:QPM2QSZNHKS23Z7629MMS6S4CWEF74VCWVY22GDX6A",
+		"ppvptpappyxx3m78hq4smcl3pd0tttdfqccrlhgy8z",
+	}
+
+	failingTests := []string{
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6b",
+		"bchtest:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		"qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6A",
+		"qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidBchAddress(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidBchAddress(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_DogeAddress(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"D5oULHCPTEVFPSje66rfSoXz1fMYLhnFiB",
+		"DKgUPR1foeiU6qLXHT4kMLjVUFrkR6XDjo",
+	}
+
+	failingTests := []string{
+		"D5oULHCPTEVFPSje66rfSoXz1fMYLhnFiC",
+		"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+		"9rXbkMyi1S6thykRoXAZcY8fwUKYsy6cXE",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidDogeAddress(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidDogeAddress(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_XmrAddress(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A",
+		"86eHGeoFxRQRs1ZCNgsqLCMLtAPbUG4Ex9CGnoLUYjZqb9ttwLm2PZF3mUsu59VYDkKeUFGr8M1KxDJQt4HnWWn7TzxfUQ3",
+		"4DkgKMUyFHJF4h8RKdkN6sDngUeWv1PSGQXuAUoakrK6Tc59odwg2J74x1fvBz15hzinz13rsSZ6FAhEn1RgvdY9C47nuWwk4WwLBQMrfQ",
+	}
+
+	failingTests := []string{
+		"44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3B",
+		"44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3",
+		"zzzzzzzzzzz",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidXmrAddress(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidXmrAddress(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_SolAddress(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"So11111111111111111111111111111111111111112",
+		"11111111111111111111111111111111",
+		"7tUAv95W9QwyzY6NmdfnJoqfEi1YHBoNCh5qffrKorpm",
+	}
+
+	failingTests := []string{
+		"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+		"So1111111111111111111111111111111111111111",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidSolAddress(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidSolAddress(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_TrxAddress(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+		"TGzZL2nY4Xayk4BNDAhuVcV81Bk4X2veGL",
+	}
+
+	failingTests := []string{
+		"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u",
+		"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidTrxAddress(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidTrxAddress(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_XrpAddress(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+		"rrrrrrrrrrrrrrrrrrrrBZbvji",
+	}
+
+	failingTests := []string{
+		"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTj",
+		"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+		"rHb9CJAWyB4rj91VRWn96DkukG4bwdty0h",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidXrpAddress(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidXrpAddress(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_SSN(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"123-45-6789",
+		"899-99-9999",
+		"001 01 0001",
+	}
+
+	failingTests := []string{
+		"000-12-3456",
+		"666-12-3456",
+		"912-34-5678",
+		"123-00-4567",
+		"123-45-0000",
+		"078-05-1120",
+		"12-345-678",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidSSN(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidSSN(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_NINO(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"AB123456C",
+		"AB 12 34 56 C",
+		"ab 12 34 56 c",
+	}
+
+	failingTests := []string{
+		"DA123456C",
+		"AO123456C",
+		"GB123456A",
+		"NK123456A",
+		"AB123456E",
+		"AB12345C",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidNINO(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidNINO(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_SIN(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"130 692 544",
+		"130-692-544",
+	}
+
+	failingTests := []string{
+		"130 692 545",
+		"046 454 286",
+		"830 692 541",
+		"13069254",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidSIN(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidSIN(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_NIR(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"1 84 12 76 451 089 46",
+		"2 69 05 2A 588 157 17",
+		"269052B58815744",
+	}
+
+	failingTests := []string{
+		"1 84 12 76 451 089 47",
+		"5 84 12 76 451 089 46",
+		"1 84 12 76 451 089",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidNIR(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidNIR(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_SteuerID(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"86095742719",
+		"47036892816",
+		"65929970489",
+		"57549285017",
+		"25768131411",
+	}
+
+	failingTests := []string{
+		"86095742718",
+		"06095742719",
+		"12345678903",
+		"11111111116",
+		"8609574271",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidSteuerID(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidSteuerID(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_FiscalCode(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"RSSMRA85T10A562S",
+		"rssmra85t10a562s",
+	}
+
+	failingTests := []string{
+		"RSSMRA85T10A562T",
+		"RSSMRA85Z10A562S",
+		"RSSMRA85T35A562S",
+		"RSSMRA85T10A562",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidFiscalCode(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidFiscalCode(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_DNI(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"12345678Z",
+		"12345678-z",
+		"00000000T",
+	}
+
+	failingTests := []string{
+		"12345678A",
+		"1234567Z",
+		"ABCDEFGHZ",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidDNI(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidDNI(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_NIE(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"X1234567L",
+		"X-1234567-L",
+	}
+
+	failingTests := []string{
+		"X1234567A",
+		"A1234567L",
+		"X123456L",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidNIE(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidNIE(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_BSN(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"111222333",
+		"123456782",
+		"1112.22.333",
+	}
+
+	failingTests := []string{
+		"111222334",
+		"000000000",
+		"12345678",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidBSN(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidBSN(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_Aadhaar(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"2341 2341 2346",
+		"234123412346",
+	}
+
+	failingTests := []string{
+		"2341 2341 2347",
+		"1341 2341 2346",
+		"2341 2341 234",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidAadhaar(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidAadhaar(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_PAN(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"ABCPE1234F",
+		"AAAAA9999Z",
+	}
+
+	failingTests := []string{
+		"ABCDE1234F",
+		"ABCPE12345",
+		"abcpe1234f",
+		"ABCPE1234",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidPAN(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidPAN(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_CPF(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"529.982.247-25",
+		"52998224725",
+	}
+
+	failingTests := []string{
+		"529.982.247-26",
+		"111.111.111-11",
+		"5299822472",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidCPF(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidCPF(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_CNPJ(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"11.222.333/0001-81",
+		"11222333000181",
+	}
+
+	failingTests := []string{
+		"11.222.333/0001-82",
+		"00.000.000/0000-00",
+		"1122233300018",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidCNPJ(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidCNPJ(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_RRN(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"900101-1234568",
+		"9001011234568",
+		"210315-3123456",
+	}
+
+	failingTests := []string{
+		"900101-1234567",
+		"900230-1234568",
+		"900101-9234568",
+		"90010112345",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidRRN(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidRRN(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_EIN(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"12-3456789",
+		"123456789",
+		"98-7654321",
+	}
+
+	failingTests := []string{
+		"00-1234567",
+		"07-1234567",
+		"89-1234567",
+		"12-345678",
+		"12-345678a",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidEIN(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidEIN(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_ITIN(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	tests := []string{
+		"912-70-1234",
+		"900 50 1234",
+		"999991234",
+	}
+
+	failingTests := []string{
+		"812-70-1234",
+		"912-49-1234",
+		"912-66-1234",
+		"912-89-1234",
+		"912-93-1234",
+		"912-70-123",
+	}
+
+	for _, test := range tests {
+		assert.True(ValidITIN(test), "%s should be valid", test)
+	}
+
+	for _, test := range failingTests {
+		assert.False(ValidITIN(test), "%s should not be valid", test)
+	}
+}
+
+func TestValidate_DriversLicenseStates(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	assert.Equal([]string{"CA", "MO", "NE", "NY", "OH"}, DriversLicenseStates("D1234567"))
+	assert.Equal([]string{"FL", "IL", "MD", "MI", "MN"}, DriversLicenseStates("F123456789012"))
+	assert.Equal([]string{"NH"}, DriversLicenseStates("12ABC34567"))
+	assert.Equal([]string{"WA"}, DriversLicenseStates("WDLABCD1234E"))
+	assert.Empty(DriversLicenseStates("hello"))
+}